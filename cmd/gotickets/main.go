@@ -5,13 +5,34 @@ import (
 	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"gotickets/internal/cli"
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
 	"gotickets/pkg/gotickets"
 )
 
 func main() {
-	p := tea.NewProgram(gotickets.NewModel(), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
-		fmt.Printf("Ошибка запуска приложения: %v", err)
+	if len(os.Args) > 1 {
+		env := &cli.Env{FS: &storage.RealFileSystem{}, Stdin: os.Stdin, Stdout: os.Stdout, Stderr: os.Stderr}
+		os.Exit(cli.Run(env, os.Args[1:]))
+	}
+
+	model := gotickets.NewModel()
+	model.WatchTickets()
+	defer model.StopWatching()
+	options := []tea.ProgramOption{tea.WithAltScreen()}
+	if model.MouseEnabled() {
+		options = append(options, tea.WithMouseCellMotion())
+	}
+	p := tea.NewProgram(model, options...)
+	finalModel, err := p.Run()
+	if err != nil {
+		fmt.Printf(i18n.T("app.run_failed"), err)
 		os.Exit(1)
 	}
+	if m, ok := finalModel.(gotickets.Model); ok {
+		if err := m.SaveState(); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("app.state_save_failed", err))
+		}
+	}
 }
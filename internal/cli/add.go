@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+func runAdd(env *Env, args []string) int {
+	flags := flag.NewFlagSet("add", flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.add.usage"))
+		fmt.Fprintln(env.Stderr, i18n.T("cli.add.usage.keys"))
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() < 1 {
+		flags.Usage()
+		return 2
+	}
+
+	ticketStorage, err := loadStorage(env)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.load_failed", err))
+		return 1
+	}
+
+	ref := flags.Arg(0)
+	title := strings.TrimSpace(strings.Join(flags.Args()[1:], " "))
+	if title == "" {
+		// Without a title the ticket is named after its key or link
+		resolved, err := ticketStorage.ResolveNewURL(ref)
+		if err != nil {
+			fmt.Fprintln(env.Stderr, i18n.T("cli.error", err))
+			return 1
+		}
+		title = resolved.Key
+		if title == "" {
+			title = resolved.URL
+		}
+	}
+
+	ticket, err := ticketStorage.AddTicket(title, ref)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.error", err))
+		return 1
+	}
+	if err := ticketStorage.Save(); err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.save_failed", err))
+		return 1
+	}
+	fmt.Fprintln(env.Stdout, i18n.T("cli.add.done", ticket.GetTitle(), ticket.URL))
+	return 0
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"gotickets/internal/config"
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+)
+
+// command is a single CLI subcommand
+type command struct {
+	name    string
+	summary string
+	run     func(env *Env, args []string) int
+}
+
+// Env carries the dependencies shared by all subcommands
+type Env struct {
+	FS     storage.FileSystem
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+func commands() []command {
+	return []command{
+		{name: "add", summary: i18n.T("cli.add.summary"), run: runAdd},
+		{name: "import", summary: i18n.T("cli.import.summary"), run: runImport},
+		{name: "list", summary: i18n.T("cli.list.summary"), run: runList},
+		{name: "search", summary: i18n.T("cli.search.summary"), run: runSearch},
+		{name: "pin", summary: i18n.T("cli.pin.summary"), run: runPin},
+		{name: "unpin", summary: i18n.T("cli.unpin.summary"), run: runUnpin},
+	}
+}
+
+// Run executes the subcommand named by args[0] and returns the process exit code
+func Run(env *Env, args []string) int {
+	setupLanguage(env)
+	if len(args) == 0 {
+		printUsage(env.Stderr)
+		return 2
+	}
+	for _, cmd := range commands() {
+		if cmd.name == args[0] {
+			return cmd.run(env, args[1:])
+		}
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(env.Stdout)
+		return 0
+	}
+	fmt.Fprintln(env.Stderr, i18n.T("cli.unknown_command", args[0]))
+	printUsage(env.Stderr)
+	return 2
+}
+
+// setupLanguage switches the messages to the language from the config file or LANG
+func setupLanguage(env *Env) {
+	cfg, _ := config.Load(env.FS)
+	if err := i18n.Setup(cfg.Language, os.Getenv); err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.warning", err))
+	}
+}
+
+// loadStorage loads the tickets and applies the URL rules from the config file
+func loadStorage(env *Env) (*storage.TicketStorage, error) {
+	ticketStorage, err := storage.LoadTicketsWithFS(env.FS)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.Load(env.FS)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.warning", i18n.T("config.defaults", err)))
+	}
+	ticketStorage.SetURLPolicy(cfg.URLPolicy())
+	return ticketStorage, nil
+}
+
+// printTickets writes tickets as their list title followed by the link
+func printTickets(env *Env, tickets []storage.Ticket) {
+	for _, ticket := range tickets {
+		fmt.Fprintf(env.Stdout, "%s\n  %s\n", ticket.GetTitle(), ticket.URL)
+	}
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, i18n.T("cli.usage"))
+	fmt.Fprintln(w, i18n.T("cli.usage.interactive"))
+	fmt.Fprintln(w, "\n"+i18n.T("cli.usage.commands"))
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+)
+
+func runImport(env *Env, args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	dryRun := flags.Bool("dry-run", false, i18n.T("cli.import.flag.dry_run"))
+	formatSpec := flags.String("format", "auto", i18n.T("cli.import.flag.format"))
+	flags.Usage = func() {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.import.usage"))
+		fmt.Fprintln(env.Stderr, i18n.T("cli.import.usage.stdin"))
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	format, err := storage.ParseImportFormat(*formatSpec)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.import.format_error", err))
+		return 2
+	}
+
+	ticketStorage, err := loadStorage(env)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.load_failed", err))
+		return 1
+	}
+	var preview *storage.ImportPreview
+	if path := flags.Arg(0); path == "-" {
+		preview, err = ticketStorage.PreviewImport(env.Stdin, format)
+	} else {
+		preview, err = ticketStorage.PreviewImportFile(path, format)
+	}
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.import.failed", err))
+		return 1
+	}
+
+	if *dryRun {
+		printPreview(env, preview)
+		printFormatCounts(env, preview)
+		printImportResult(env, preview.Summary())
+		return 0
+	}
+
+	result, err := ticketStorage.ApplyImport(preview)
+	printImportResult(env, result)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.import.failed", err))
+		return 1
+	}
+	return 0
+}
+
+func printPreview(env *Env, preview *storage.ImportPreview) {
+	for _, c := range preview.Candidates {
+		switch c.Status {
+		case storage.ImportInvalid:
+			fmt.Fprintln(env.Stdout, i18n.T("cli.import.line_invalid", c.Line, c.Error))
+		case storage.ImportDuplicate:
+			fmt.Fprintln(env.Stdout, i18n.T("cli.import.line_duplicate", c.Line, c.URL, c.Title, c.Format))
+		default:
+			fmt.Fprintln(env.Stdout, i18n.T("cli.import.line_new", c.Line, c.URL, c.Title, c.Format))
+		}
+	}
+}
+
+func printFormatCounts(env *Env, preview *storage.ImportPreview) {
+	counts := preview.FormatCounts()
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(env.Stdout, i18n.T("cli.import.format_count", name, counts[name]))
+	}
+}
+
+func printImportResult(env *Env, result *storage.ImportResult) {
+	fmt.Fprintln(env.Stdout, i18n.T("import.result.added", result.Added))
+	fmt.Fprintln(env.Stdout, i18n.T("import.result.duplicates", result.Duplicates))
+	fmt.Fprintln(env.Stdout, i18n.T("import.result.errors", result.Errors))
+	for _, errLine := range result.ErrorLines {
+		fmt.Fprintf(env.Stderr, "  • %s\n", errLine)
+	}
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+)
+
+func runList(env *Env, args []string) int {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	sortName := flags.String("sort", "added", i18n.T("cli.list.flag.sort"))
+	archived := flags.Bool("archived", false, i18n.T("cli.list.flag.archived"))
+	flags.Usage = func() {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.list.usage"))
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	mode, err := storage.ParseSortMode(*sortName)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.error", err))
+		return 2
+	}
+
+	ticketStorage, err := loadStorage(env)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.load_failed", err))
+		return 1
+	}
+	tickets := ticketStorage.ActiveTickets()
+	if *archived {
+		tickets = ticketStorage.Tickets
+	}
+	printTickets(env, storage.SortTickets(tickets, mode))
+	return 0
+}
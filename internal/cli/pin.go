@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"gotickets/internal/i18n"
+)
+
+func runPin(env *Env, args []string) int   { return setPinned(env, "pin", args, true) }
+func runUnpin(env *Env, args []string) int { return setPinned(env, "unpin", args, false) }
+
+func setPinned(env *Env, name string, args []string, pinned bool) int {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(env.Stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.pin.usage", name))
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() < 1 {
+		flags.Usage()
+		return 2
+	}
+
+	ticketStorage, err := loadStorage(env)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.load_failed", err))
+		return 1
+	}
+
+	code, changed := 0, 0
+	for _, ref := range flags.Args() {
+		ticket, err := ticketStorage.FindTicket(ref)
+		if err == nil {
+			err = ticketStorage.SetPinned(ticket.ID, pinned)
+		}
+		if err != nil {
+			fmt.Fprintln(env.Stderr, i18n.T("cli.error", err))
+			code = 1
+			continue
+		}
+		changed++
+		if pinned {
+			fmt.Fprintln(env.Stdout, i18n.T("cli.pin.pinned", ticket.GetTitle()))
+		} else {
+			fmt.Fprintln(env.Stdout, i18n.T("cli.pin.unpinned", ticket.GetTitle()))
+		}
+	}
+	if changed == 0 {
+		return code
+	}
+	if err := ticketStorage.Save(); err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.save_failed", err))
+		return 1
+	}
+	return code
+}
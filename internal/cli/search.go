@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+// runSearch takes no flags: every argument is part of the query, so a leading
+// -term is an exclusion rather than an unknown flag
+func runSearch(env *Env, args []string) int {
+	if len(args) == 1 && (args[0] == "-h" || args[0] == "-help" || args[0] == "--help") {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.search.usage"))
+		fmt.Fprintln(env.Stderr, i18n.T("cli.search.usage.example"))
+		fmt.Fprintln(env.Stderr, i18n.T("cli.search.usage.fields"))
+		return 2
+	}
+
+	ticketStorage, err := loadStorage(env)
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.load_failed", err))
+		return 1
+	}
+
+	tickets, err := ticketStorage.Query(joinQueryArgs(args))
+	if err != nil {
+		fmt.Fprintln(env.Stderr, i18n.T("cli.search.query_error", err))
+		return 2
+	}
+	printTickets(env, tickets)
+	if len(tickets) == 0 {
+		fmt.Fprintln(env.Stderr, i18n.T("search.nothing_found"))
+		return 1
+	}
+	return 0
+}
+
+// joinQueryArgs rebuilds a query from shell arguments, restoring the quotes
+// the shell removed around phrases like "login bug" or title:"login bug"
+func joinQueryArgs(args []string) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		if !strings.ContainsAny(arg, " \t") || strings.Contains(arg, `"`) {
+			parts[i] = arg
+			continue
+		}
+		if field, value, found := strings.Cut(arg, ":"); found && !strings.ContainsAny(field, " \t") {
+			parts[i] = field + `:"` + value + `"`
+		} else {
+			parts[i] = `"` + arg + `"`
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+)
+
+// FileName is the name of the configuration file inside the data directory
+const FileName = "config.json"
+
+// Config holds user settings loaded from ~/.gotickets/config.json
+type Config struct {
+	// TrackerBaseURL expands bare ticket keys like PROJ-123 into links
+	TrackerBaseURL string `json:"tracker_base_url,omitempty"`
+	// AllowedSchemes lists accepted URL schemes, http and https by default
+	AllowedSchemes []string `json:"allowed_schemes,omitempty"`
+	// URLTemplates maps project keys to link templates, e.g.
+	// "PROJ": "https://jira.local/browse/{key}"
+	URLTemplates map[string]string `json:"url_templates,omitempty"`
+	// DefaultProject expands bare ticket numbers like 1234 into PROJ-1234
+	DefaultProject string `json:"default_project,omitempty"`
+	// KeyPreset selects the base key bindings: default, vim or emacs
+	KeyPreset string `json:"key_preset,omitempty"`
+	// Keys rebinds actions by name, e.g. "add": ["a", "n"]
+	Keys map[string][]string `json:"keys,omitempty"`
+	// Theme names the color theme: dark, light, high-contrast or no-color
+	Theme string `json:"theme,omitempty"`
+	// Language is the UI language, ru or en; empty means detect from LANG
+	Language string `json:"language,omitempty"`
+	// Mouse enables clicks and the scroll wheel (on by default); false leaves
+	// the mouse to the terminal so text can be selected
+	Mouse *bool `json:"mouse,omitempty"`
+}
+
+// Default returns the configuration used when no config file exists
+func Default() *Config {
+	return &Config{}
+}
+
+// Load reads the configuration file. A missing file yields the defaults;
+// a malformed file yields the defaults together with an error.
+func Load(fs storage.FileSystem) (*Config, error) {
+	dataDir, err := storage.DataDir(fs)
+	if err != nil {
+		return Default(), err
+	}
+	data, err := fs.ReadFile(filepath.Join(dataDir, FileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Default(), nil
+		}
+		return Default(), fmt.Errorf(i18n.T("file.read_failed"), FileName, err)
+	}
+	cfg := Default()
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return Default(), fmt.Errorf(i18n.T("file.malformed"), FileName, err)
+	}
+	return cfg, nil
+}
+
+// MouseEnabled reports whether the interface should capture the mouse
+func (c *Config) MouseEnabled() bool {
+	return c.Mouse == nil || *c.Mouse
+}
+
+// URLPolicy returns the link validation rules described by the configuration
+func (c *Config) URLPolicy() storage.URLPolicy {
+	return storage.URLPolicy{
+		AllowedSchemes: c.AllowedSchemes,
+		TrackerBaseURL: c.TrackerBaseURL,
+		URLTemplates:   c.URLTemplates,
+		DefaultProject: c.DefaultProject,
+	}
+}
@@ -0,0 +1,428 @@
+package i18n
+
+// en is the English message catalog
+var en = map[string]string{
+	"app.run_failed":        "Failed to start the application: %v",
+	"app.state_save_failed": "Could not save the interface state: %v",
+
+	"backup.create_failed":         "Could not create a backup: %v",
+	"backup.created":               "Created backup %s",
+	"backup.created_without_label": "Created backup %s, but its label was not saved: %v",
+	"backup.delete_failed":         "Could not delete the backup: %v",
+	"backup.deleted":               "Deleted backup %s",
+	"backup.export_failed":         "Could not export the backup: %v",
+	"backup.exported":              "Exported %s to %s",
+	"backup.list_failed":           "Could not list the backups: %v",
+	"backup.nothing_to_backup":     "Nothing to back up: the tickets file does not exist yet",
+	"backup.op.add":                "ticket added",
+	"backup.op.delete":             "deletion",
+	"backup.op.edit":               "bulk edit",
+	"backup.op.import":             "import",
+	"backup.op.manual":             "manual",
+	"backup.op.unknown":            "unknown operation",
+	"backup.placeholder.export":    "/path/to/file.json",
+	"backup.placeholder.label":     "e.g. before cleanup",
+	"backup.restore_failed":        "Could not restore the backup: %v",
+	"backup.restored":              "Restored from %s",
+
+	"bulk.already_deleted":       "The selected tickets are already deleted",
+	"bulk.copied":                "Links copied: %d",
+	"bulk.copy_failed":           "Could not copy the links: %v",
+	"bulk.describe.archive":      "Archive tickets: %d",
+	"bulk.describe.clear_status": "Clear the status of tickets: %d",
+	"bulk.describe.delete":       "Delete tickets: %d",
+	"bulk.describe.export":       "Export tickets (%d) to %s",
+	"bulk.describe.open":         "Open tickets in the browser: %d",
+	"bulk.describe.status":       "Set status “%s” on tickets: %d",
+	"bulk.describe.tag":          "Add tag “%s” to tickets: %d",
+	"bulk.describe.unarchive":    "Unarchive tickets: %d",
+	"bulk.done":                  "Done: %s",
+	"bulk.export_failed":         "Export failed: %v",
+	"bulk.exported":              "Exported %d tickets to %s",
+	"bulk.failed":                "The operation failed: %v",
+	"bulk.nothing_selected":      "No tickets selected (%s - select, %s - range, %s - all)",
+	"bulk.open_failed":           "Could not open: %s",
+	"bulk.opened":                "Tickets opened: %d",
+	"bulk.placeholder.export":    "Enter path to export file...",
+	"bulk.placeholder.status":    "Enter status (empty to clear)...",
+	"bulk.placeholder.tag":       "Enter tag...",
+	"bulk.range_started":         "Range start marked, press %s on the last ticket to select the range",
+
+	"cli.add.done":              "Added ticket %s\n%s",
+	"cli.add.summary":           "add a ticket by link or key",
+	"cli.add.usage":             "Usage: gotickets add <link|key|number> [title]",
+	"cli.add.usage.keys":        "A key (PROJ-123) or number (123) is expanded into a link with url_templates from config.json.",
+	"cli.error":                 "Error: %v",
+	"cli.import.failed":         "Import failed: %v",
+	"cli.import.flag.dry_run":   "show what would be imported without saving anything",
+	"cli.import.flag.format":    "line format: auto, tsv, 'url - title', 'title | url' or re:<regexp> with the groups url and title",
+	"cli.import.format_count":   "Format %s, lines: %d",
+	"cli.import.format_error":   "Format error: %v",
+	"cli.import.line_duplicate": "= line %d: %s - %s (duplicate, format %s)",
+	"cli.import.line_invalid":   "! line %d: %s",
+	"cli.import.line_new":       "+ line %d: %s - %s (format %s)",
+	"cli.import.summary":        "import tickets from a file",
+	"cli.import.usage":          "Usage: gotickets import [--dry-run] [--format <format>] <file|->",
+	"cli.import.usage.stdin":    "With '-' instead of a file, lines are read from standard input.",
+	"cli.list.flag.archived":    "include archived tickets",
+	"cli.list.flag.sort":        "order: added, created, -created, title, number, host, opened, recent, status",
+	"cli.list.summary":          "list tickets",
+	"cli.list.usage":            "Usage: gotickets list [--sort order] [--archived]",
+	"cli.load_failed":           "Failed to load tickets: %v",
+	"cli.pin.pinned":            "Pinned: %s",
+	"cli.pin.summary":           "pin tickets to the top of the list",
+	"cli.pin.unpinned":          "Unpinned: %s",
+	"cli.pin.usage":             "Usage: gotickets %s <id|number|key|link>...",
+	"cli.save_failed":           "Failed to save: %v",
+	"cli.search.query_error":    "Query error: %v",
+	"cli.search.summary":        "search tickets with a query",
+	"cli.search.usage":          "Usage: gotickets search <query>",
+	"cli.search.usage.example":  "Example: gotickets search host:github.com created:>2025-01-01 -wip \"login bug\"",
+	"cli.search.usage.fields":   "Fields: title, url, host, num, id, tag, status, created. -word excludes, OR combines conditions.",
+	"cli.unknown_command":       "Unknown command: %s",
+	"cli.unpin.summary":         "unpin tickets",
+	"cli.usage":                 "Usage: gotickets [command] [arguments]",
+	"cli.usage.commands":        "Commands:",
+	"cli.usage.interactive":     "Without a command the interactive interface starts.",
+	"cli.warning":               "Warning: %v",
+
+	"config.defaults": "using default settings: %v",
+
+	"file.malformed":   "error in %s: %v",
+	"file.read_failed": "could not read %s: %v",
+
+	"format.bad_regexp":     "invalid regular expression: %v",
+	"format.missing_fields": "the format must contain url and title: %q",
+	"format.no_separator":   "the format has no separator: %q",
+	"format.regexp_groups":  "the regular expression must contain the groups (?P<url>...) and (?P<title>...)",
+
+	"group.host":    "by site",
+	"group.none":    "no groups",
+	"group.project": "by project",
+
+	"help.actions":            "actions",
+	"help.all_none":           "all/none",
+	"help.apply":              "apply",
+	"help.apply_search":       "apply search",
+	"help.back":               "back",
+	"help.back_or_clear":      "clear filter / back",
+	"help.back_to_list":       "back to list",
+	"help.cancel":             "cancel",
+	"help.clear_selection":    "clear selection",
+	"help.close_help":         "close help",
+	"help.continue":           "continue",
+	"help.continue_title":     "continue to title",
+	"help.copy":               "copy",
+	"help.delete":             "delete",
+	"help.details":            "details",
+	"help.edit_title":         "edit title",
+	"help.export":             "export",
+	"help.filter":             "filter",
+	"help.force_quit":         "quit immediately",
+	"help.import":             "import",
+	"help.keep_mine":          "keep mine",
+	"help.mark":               "mark",
+	"help.navigate":           "navigate",
+	"help.new_backup":         "new backup",
+	"help.no_cancel":          "no, cancel",
+	"help.open":               "open",
+	"help.page":               "page",
+	"help.path_format":        "path/format",
+	"help.quick_filter":       "quick filter",
+	"help.reload":             "load from disk",
+	"help.restore":            "restore",
+	"help.run":                "run",
+	"help.save":               "save",
+	"help.save_ticket":        "save ticket",
+	"help.save_title":         "save title",
+	"help.scroll":             "scroll",
+	"help.section.bulk":       "Bulk actions",
+	"help.section.detail":     "Ticket details",
+	"help.section.general":    "General",
+	"help.section.navigation": "Navigation",
+	"help.section.search":     "Search and filters",
+	"help.section.selection":  "Multiple selection",
+	"help.section.tickets":    "Tickets",
+	"help.section.view":       "View and commands",
+	"help.select_all":         "select all",
+	"help.show_in_list":       "show in list",
+	"help.start_import":       "start import",
+	"help.yes_delete":         "yes, delete",
+	"help.yes_restore":        "yes, restore",
+
+	"i18n.unknown_language": "unknown language %q (available: %s)",
+
+	"import.bad_format":        "invalid format",
+	"import.cancelled":         "import cancelled: %v",
+	"import.empty_fields":      "empty link or title",
+	"import.error_line":        "Line %d: %s",
+	"import.failed":            "Import failed: %v",
+	"import.open_failed":       "could not open the file: %v",
+	"import.read_failed":       "error reading data: %v",
+	"import.result.added":      "Tickets added: %d",
+	"import.result.duplicates": "Duplicates skipped: %d",
+	"import.result.errors":     "Format errors: %d",
+	"import.result.skipped":    "Skipped manually: %d",
+	"import.save_failed":       "import cancelled, could not save the tickets: %v",
+
+	"input.placeholder.import": "Enter path to .txt file...",
+	"input.placeholder.search": "Search tickets...",
+	"input.placeholder.text":   "Enter text...",
+	"input.placeholder.title":  "Enter ticket title...",
+	"input.placeholder.url":    "Enter URL or ticket key (PROJ-123)...",
+
+	"key.space": "Space",
+
+	"keys.conflicts":            "key conflicts: %s (using the preset keys)",
+	"keys.help.add":             "add",
+	"keys.help.back":            "back",
+	"keys.help.backups":         "backups",
+	"keys.help.bottom":          "go to end",
+	"keys.help.bulk":            "bulk actions",
+	"keys.help.choose":          "choose",
+	"keys.help.clear_selection": "clear selection",
+	"keys.help.confirm":         "yes",
+	"keys.help.copy":            "copy url",
+	"keys.help.delete":          "delete",
+	"keys.help.deny":            "no",
+	"keys.help.details":         "details",
+	"keys.help.down":            "down",
+	"keys.help.filters":         "filters",
+	"keys.help.group":           "group",
+	"keys.help.help":            "help",
+	"keys.help.import":          "import",
+	"keys.help.open":            "open",
+	"keys.help.page_down":       "next page",
+	"keys.help.page_up":         "prev page",
+	"keys.help.palette":         "commands",
+	"keys.help.paste":           "paste links",
+	"keys.help.pin":             "pin",
+	"keys.help.quit":            "quit",
+	"keys.help.recent":          "recent",
+	"keys.help.refresh":         "refresh",
+	"keys.help.save":            "retry save",
+	"keys.help.save_filter":     "save filter",
+	"keys.help.search":          "search",
+	"keys.help.select":          "select",
+	"keys.help.select_all":      "select all",
+	"keys.help.select_range":    "select range",
+	"keys.help.sort":            "sort",
+	"keys.help.top":             "go to start",
+	"keys.help.up":              "up",
+	"keys.no_keys":              "no keys given for action %q",
+	"keys.unknown_action":       "unknown action %q",
+	"keys.unknown_preset":       "unknown key preset %q",
+
+	"list.archived": "archived: %d",
+	"list.filter":   "Filter “%s”",
+	"list.grouping": "Grouping: %s",
+	"list.groups":   "Groups: %s",
+	"list.no_group": "No group",
+	"list.pinned":   "Pinned",
+	"list.shown":    "Shown: %d of %d",
+	"list.sort":     "Sort: %s",
+	"list.total":    "Tickets: %d",
+
+	"palette.add":               "Add ticket",
+	"palette.backups":           "Backups",
+	"palette.bulk":              "Bulk actions",
+	"palette.clear_selection":   "Clear selection",
+	"palette.details":           "Ticket details",
+	"palette.export":            "Export to file",
+	"palette.filters":           "Saved filters",
+	"palette.group":             "Group: %s",
+	"palette.help":              "Key bindings",
+	"palette.import":            "Import from file",
+	"palette.nothing_to_export": "No tickets to export",
+	"palette.paste":             "Paste links from clipboard",
+	"palette.pin":               "Pin or unpin ticket",
+	"palette.placeholder":       "Start typing a command...",
+	"palette.quit":              "Quit",
+	"palette.recent":            "Recent tickets",
+	"palette.refresh":           "Refresh list",
+	"palette.save":              "Retry save",
+	"palette.save_filter":       "Save filter",
+	"palette.search":            "Search",
+	"palette.select_all":        "Select all",
+	"palette.sort":              "Sort: %s",
+	"palette.theme":             "Theme: %s",
+
+	"paste.empty":       "the clipboard is empty",
+	"paste.read_failed": "Could not read the clipboard: %v",
+
+	"recent.empty": "No recently opened or copied tickets",
+
+	"reload.check_failed": "Could not load the changes of tickets.json, kept the tickets in memory: %v",
+	"reload.done":         "Tickets reloaded: the file was changed by another program",
+	"reload.kept":         "Kept the changes in memory, they will replace the file on save (%s)",
+
+	"saved.apply_failed": "Filter %q not applied: %s",
+	"saved.deleted":      "Filter %q deleted",
+	"saved.missing":      "No saved filter %d (%s - list of filters)",
+	"saved.no_search":    "Search first (%s), then save the search",
+	"saved.placeholder":  "Enter filter name...",
+	"saved.save_failed":  "Could not save the filter: %v",
+	"saved.saved":        "Filter %q saved",
+
+	"search.nothing_found": "Nothing found",
+
+	"size.bytes": "%d B",
+	"size.kb":    "%.1f KB",
+	"size.mb":    "%.1f MB",
+
+	"sort.added":        "by date added",
+	"sort.created_asc":  "oldest first",
+	"sort.created_desc": "newest first",
+	"sort.host":         "by site",
+	"sort.last_opened":  "recently opened",
+	"sort.number":       "by number",
+	"sort.recent":       "recently used",
+	"sort.status":       "by status",
+	"sort.title":        "by title",
+	"sort.unknown":      "unknown sort order %q (available: %s)",
+
+	"status.dirty":           "There are unsaved changes (%s - save)",
+	"status.nothing_to_save": "No unsaved changes",
+	"status.quit_unsaved":    "There are unsaved changes: %s - retry, %s - quit without saving",
+	"status.save_failed":     "Could not save the tickets: %v (%s - retry)",
+	"status.saved":           "Changes saved",
+
+	"storage.backup.corrupted":           "backup %s is corrupted: %v",
+	"storage.backup.create_failed":       "could not write the backup: %v",
+	"storage.backup.delete_failed":       "could not delete %s: %v",
+	"storage.backup.not_backup":          "%s is not a backup",
+	"storage.backup.not_found":           "backup %s not found",
+	"storage.backup.read_failed":         "could not read %s: %v",
+	"storage.backup.read_tickets_failed": "could not read tickets.json for the backup: %v",
+	"storage.backup.remove_unsupported":  "the file system cannot delete files",
+	"storage.backup.restore_failed":      "could not write tickets.json: %v",
+	"storage.bulk.bad_tag":               "a tag cannot be empty or contain spaces",
+	"storage.bulk.none_found":            "none of the selected tickets",
+	"storage.bulk.write_failed":          "could not write %s: %v",
+	"storage.err.backup_failed":          "could not create a backup",
+	"storage.err.backup_index":           "could not record the backup details",
+	"storage.err.duplicate_url":          "a ticket with this link already exists",
+	"storage.err.empty_name":             "the name cannot be empty",
+	"storage.err.empty_title":            "the ticket title cannot be empty",
+	"storage.err.invalid_url":            "invalid link",
+	"storage.err.not_found":              "ticket not found",
+	"storage.query.bad_date":             "invalid date %q (expected YYYY-MM-DD or YYYY-MM)",
+	"storage.query.bad_id":               "id must be a number: %q",
+	"storage.query.bad_yes_no":           "expected yes or no: %q",
+	"storage.query.empty_value":          "empty value for field %s",
+	"storage.query.or_placement":         "OR must stand between conditions",
+	"storage.query.unclosed_quote":       "unclosed quote",
+	"storage.saved.empty_query":          "empty search query",
+	"storage.saved.filter":               "filter %q",
+	"storage.url.bad_scheme":             "scheme %q is not supported (allowed: %s)",
+	"storage.url.empty":                  "empty link",
+	"storage.url.no_host":                "the link has no server address",
+	"storage.url.no_project":             "no project is set for number %s (default_project in config.json)",
+	"storage.url.no_scheme":              "no scheme given (for example, https://)",
+	"storage.url.no_tracker":             "no tracker is configured for key %s (url_templates or tracker_base_url in config.json)",
+	"storage.url.spaces":                 "the link contains spaces",
+
+	"theme.no_color": "NO_COLOR is set, keeping the no-color theme",
+	"theme.set":      "Theme: %s",
+	"theme.unknown":  "unknown theme %q (available: %s)",
+
+	"ticket.add_failed":      "Could not add the ticket: %v",
+	"ticket.already_deleted": "The ticket is already deleted",
+	"ticket.copied":          "Link copied",
+	"ticket.copy_failed":     "Could not copy the link: %v",
+	"ticket.delete_failed":   "The ticket was not deleted: %v",
+	"ticket.open_failed":     "Could not open the browser: %v",
+	"ticket.pinned":          "Ticket pinned",
+	"ticket.unpinned":        "Ticket unpinned",
+
+	"time.days_ago":    "%d days ago",
+	"time.hours_ago":   "%d h ago",
+	"time.just_now":    "just now",
+	"time.minutes_ago": "%d min ago",
+
+	"ui.config_defaults":      "Using default settings: %v",
+	"ui.keys_warning":         "Key settings: %v",
+	"ui.state_restore_failed": "Could not restore the interface state: %v",
+
+	"view.add.title.header":       "Add a new ticket - Title",
+	"view.add.title.key":          "Key: %s",
+	"view.add.title.link":         "Link: %s",
+	"view.add.title.prompt":       "Enter the ticket title:",
+	"view.add.url.header":         "Add a new ticket - Link",
+	"view.add.url.prompt":         "Enter the link:",
+	"view.backup_delete.header":   "🗑️  Delete backup",
+	"view.backup_delete.question": "Delete this backup?",
+	"view.backup_delete.warning":  "The file will be deleted permanently!",
+	"view.backups.count":          "Backups found: %d",
+	"view.backups.empty":          "No backups found.",
+	"view.backups.export":         "Export %s to:",
+	"view.backups.filter":         "Filter: ",
+	"view.backups.filtered":       "Showing %d of %d · filter: %s",
+	"view.backups.header":         "Backups",
+	"view.backups.label":          "Label of the new backup (may be empty):",
+	"view.backups.no_match":       "No backups match the filter.",
+	"view.backups.tickets":        "tickets: %d",
+	"view.backups.unreadable":     "file is damaged",
+	"view.bulk.archive":           "archive",
+	"view.bulk.copy":              "copy links",
+	"view.bulk.export":            "export to file",
+	"view.bulk.header":            "Actions on selected tickets (%d)",
+	"view.bulk.open":              "open all in the browser",
+	"view.bulk.prompt.export":     "Export file (“link - title” format):",
+	"view.bulk.prompt.status":     "Status for the selected tickets (empty to clear):",
+	"view.bulk.prompt.tag":        "Tag for the selected tickets:",
+	"view.bulk.status":            "change status",
+	"view.bulk.tag":               "add tag",
+	"view.bulk.unarchive":         "unarchive",
+	"view.confirm.backup":         "One backup is created before the change.",
+	"view.confirm.header":         "Confirmation",
+	"view.confirm.more":           "... and %d more",
+	"view.delete.header":          "Confirm deletion",
+	"view.delete.question":        "Are you sure you want to delete the ticket?",
+	"view.delete.ticket":          "Ticket: #%d - %s",
+	"view.detail.archived":        "Archived",
+	"view.detail.copied":          "Copied",
+	"view.detail.created":         "Created",
+	"view.detail.key":             "Key",
+	"view.detail.link":            "Link",
+	"view.detail.never":           "never",
+	"view.detail.not_found":       "Ticket not found",
+	"view.detail.opened":          "Opened",
+	"view.detail.pinned":          "Pinned",
+	"view.detail.status":          "Status",
+	"view.detail.tags":            "Tags",
+	"view.detail.usage":           "%s (%s), %d in total",
+	"view.detail.yes":             "yes",
+	"view.help.header":            "Key bindings",
+	"view.help.lines":             "lines %d-%d of %d",
+	"view.import.format":          "Line format (auto, 'url - title', 'title | url', tsv, re:<regular expression>):",
+	"view.import.header":          "Import tickets",
+	"view.import.path":            "Enter the path to a .txt file:",
+	"view.import.result.errors":   "Errors:",
+	"view.import.result.header":   "Import result",
+	"view.palette.count":          "Commands: %d",
+	"view.palette.empty":          "No matching commands.",
+	"view.palette.header":         "Commands",
+	"view.preview.duplicate":      "line %d: %s - %s (duplicate)",
+	"view.preview.empty":          "Nothing to import.",
+	"view.preview.header":         "Import preview",
+	"view.preview.invalid":        "line %d: %s (%s)",
+	"view.preview.new":            "line %d: %s - %s",
+	"view.preview.new_title":      "New title:",
+	"view.preview.summary":        "To add: %d • Duplicates: %d • Errors: %d",
+	"view.recent.header":          "Recent tickets",
+	"view.reload.header":          "🔄 Tickets file changed",
+	"view.reload.question":        "Another program changed tickets.json while there are unsaved changes in memory.",
+	"view.reload.warning":         "Loading from disk discards the unsaved changes!",
+	"view.restore.backup":         "Backup: %s",
+	"view.restore.header":         "Confirm restore",
+	"view.restore.question":       "Are you sure you want to restore from the backup?",
+	"view.restore.warning":        "WARNING: This replaces all current tickets!",
+	"view.save_filter.header":     "Save filter",
+	"view.save_filter.name":       "Filter name:",
+	"view.save_filter.query":      "Query: %s",
+	"view.saved.empty":            "No saved filters. Search (%s) and press %s to save the search.",
+	"view.saved.header":           "Saved filters",
+	"view.search.prompt":          "Search:",
+	"view.selected":               "Selected: %d",
+}
@@ -0,0 +1,134 @@
+// Package i18n holds the message catalogs of the user interface
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Supported languages
+const (
+	Russian = "ru"
+	English = "en"
+)
+
+// DefaultLanguage is used when neither the config nor the environment names a supported language
+const DefaultLanguage = Russian
+
+var catalogs = map[string]map[string]string{
+	Russian: ru,
+	English: en,
+}
+
+var (
+	mu      sync.RWMutex
+	current = DefaultLanguage
+)
+
+// Languages returns the supported language codes
+func Languages() []string {
+	langs := make([]string, 0, len(catalogs))
+	for lang := range catalogs {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Keys returns the message keys of a language in alphabetical order
+func Keys(lang string) []string {
+	keys := make([]string, 0, len(catalogs[lang]))
+	for k := range catalogs[lang] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Message returns the untranslated format string of a key in a language
+func Message(lang, key string) (string, bool) {
+	msg, ok := catalogs[lang][key]
+	return msg, ok
+}
+
+// Language returns the current language
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// SetLanguage switches the language of all messages
+func SetLanguage(lang string) error {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if _, ok := catalogs[lang]; !ok {
+		return fmt.Errorf(T("i18n.unknown_language"), lang, strings.Join(Languages(), ", "))
+	}
+	mu.Lock()
+	current = lang
+	mu.Unlock()
+	return nil
+}
+
+// Detect picks the language from the config value, falling back to the
+// LC_ALL, LC_MESSAGES and LANG environment variables and then to the default.
+// An unsupported configured language is reported together with the fallback.
+func Detect(configured string, getenv func(string) string) (string, error) {
+	var err error
+	if configured != "" {
+		lang := strings.ToLower(strings.TrimSpace(configured))
+		if _, ok := catalogs[lang]; ok {
+			return lang, nil
+		}
+		err = fmt.Errorf(T("i18n.unknown_language"), configured, strings.Join(Languages(), ", "))
+	}
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+		// ru_RU.UTF-8 -> ru
+		lang := strings.ToLower(value)
+		if i := strings.IndexAny(lang, "_.@"); i >= 0 {
+			lang = lang[:i]
+		}
+		if _, ok := catalogs[lang]; ok {
+			return lang, err
+		}
+		break
+	}
+	return DefaultLanguage, err
+}
+
+// Setup detects the language and makes it current
+func Setup(configured string, getenv func(string) string) error {
+	lang, err := Detect(configured, getenv)
+	SetLanguage(lang)
+	return err
+}
+
+// T returns the message of a key in the current language, formatted with args.
+// Keys missing from the current language fall back to the default language.
+func T(key string, args ...any) string {
+	lang := Language()
+	msg, ok := catalogs[lang][key]
+	if !ok {
+		if msg, ok = catalogs[DefaultLanguage][key]; !ok {
+			msg = key
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Error is an error whose text is a catalog key, so sentinel errors are
+// shown in the current language and still compare with errors.Is
+type Error string
+
+func (e Error) Error() string {
+	return T(string(e))
+}
@@ -0,0 +1,428 @@
+package i18n
+
+// ru is the Russian message catalog
+var ru = map[string]string{
+	"app.run_failed":        "Ошибка запуска приложения: %v",
+	"app.state_save_failed": "Не удалось сохранить состояние интерфейса: %v",
+
+	"backup.create_failed":         "Не удалось создать резервную копию: %v",
+	"backup.created":               "Создана резервная копия %s",
+	"backup.created_without_label": "Резервная копия %s создана, но метка не сохранена: %v",
+	"backup.delete_failed":         "Не удалось удалить резервную копию: %v",
+	"backup.deleted":               "Резервная копия %s удалена",
+	"backup.export_failed":         "Не удалось экспортировать резервную копию: %v",
+	"backup.exported":              "%s экспортирована в %s",
+	"backup.list_failed":           "Не удалось получить список резервных копий: %v",
+	"backup.nothing_to_backup":     "Нечего сохранять: файл тикетов еще не создан",
+	"backup.op.add":                "добавление тикета",
+	"backup.op.delete":             "удаление",
+	"backup.op.edit":               "массовое изменение",
+	"backup.op.import":             "импорт",
+	"backup.op.manual":             "вручную",
+	"backup.op.unknown":            "операция неизвестна",
+	"backup.placeholder.export":    "/путь/к/файлу.json",
+	"backup.placeholder.label":     "например, перед чисткой",
+	"backup.restore_failed":        "Не удалось восстановить резервную копию: %v",
+	"backup.restored":              "Восстановлено из %s",
+
+	"bulk.already_deleted":       "Выбранные тикеты уже удалены",
+	"bulk.copied":                "Скопировано ссылок: %d",
+	"bulk.copy_failed":           "Не удалось скопировать ссылки: %v",
+	"bulk.describe.archive":      "Перенести в архив тикеты: %d",
+	"bulk.describe.clear_status": "Сбросить статус у тикетов: %d",
+	"bulk.describe.delete":       "Удалить тикеты: %d",
+	"bulk.describe.export":       "Экспортировать тикеты (%d) в %s",
+	"bulk.describe.open":         "Открыть в браузере тикеты: %d",
+	"bulk.describe.status":       "Установить статус «%s» тикетам: %d",
+	"bulk.describe.tag":          "Добавить тег «%s» тикетам: %d",
+	"bulk.describe.unarchive":    "Вернуть из архива тикеты: %d",
+	"bulk.done":                  "Готово: %s",
+	"bulk.export_failed":         "Экспорт не выполнен: %v",
+	"bulk.exported":              "Экспортировано тикетов: %d в %s",
+	"bulk.failed":                "Операция не выполнена: %v",
+	"bulk.nothing_selected":      "Нет выбранных тикетов (%s - выбрать, %s - диапазон, %s - все)",
+	"bulk.open_failed":           "Не удалось открыть: %s",
+	"bulk.opened":                "Открыто тикетов: %d",
+	"bulk.placeholder.export":    "Введите путь к файлу экспорта...",
+	"bulk.placeholder.status":    "Введите статус (пусто - сбросить)...",
+	"bulk.placeholder.tag":       "Введите тег...",
+	"bulk.range_started":         "Начало диапазона отмечено, %s на последнем тикете - выбрать диапазон",
+
+	"cli.add.done":              "Добавлен тикет %s\n%s",
+	"cli.add.summary":           "добавить тикет по ссылке или ключу",
+	"cli.add.usage":             "Использование: gotickets add <ссылка|ключ|номер> [название]",
+	"cli.add.usage.keys":        "Ключ (PROJ-123) и номер (123) раскрываются в ссылку по url_templates из config.json.",
+	"cli.error":                 "Ошибка: %v",
+	"cli.import.failed":         "Ошибка импорта: %v",
+	"cli.import.flag.dry_run":   "показать, что будет импортировано, ничего не сохраняя",
+	"cli.import.flag.format":    "формат строки: auto, tsv, 'url - title', 'title | url' или re:<regexp> с группами url и title",
+	"cli.import.format_count":   "Формат %s, строк: %d",
+	"cli.import.format_error":   "Ошибка формата: %v",
+	"cli.import.line_duplicate": "= строка %d: %s - %s (дубликат, формат %s)",
+	"cli.import.line_invalid":   "! строка %d: %s",
+	"cli.import.line_new":       "+ строка %d: %s - %s (формат %s)",
+	"cli.import.summary":        "импорт тикетов из файла",
+	"cli.import.usage":          "Использование: gotickets import [--dry-run] [--format <формат>] <файл|->",
+	"cli.import.usage.stdin":    "Если вместо файла указан '-', строки читаются из стандартного ввода.",
+	"cli.list.flag.archived":    "показать и архивные тикеты",
+	"cli.list.flag.sort":        "порядок: added, created, -created, title, number, host, opened, recent, status",
+	"cli.list.summary":          "список тикетов",
+	"cli.list.usage":            "Использование: gotickets list [--sort порядок] [--archived]",
+	"cli.load_failed":           "Ошибка загрузки тикетов: %v",
+	"cli.pin.pinned":            "Закреплен: %s",
+	"cli.pin.summary":           "закрепить тикеты вверху списка",
+	"cli.pin.unpinned":          "Откреплен: %s",
+	"cli.pin.usage":             "Использование: gotickets %s <id|номер|ключ|ссылка>...",
+	"cli.save_failed":           "Ошибка сохранения: %v",
+	"cli.search.query_error":    "Ошибка в запросе: %v",
+	"cli.search.summary":        "поиск тикетов по запросу",
+	"cli.search.usage":          "Использование: gotickets search <запрос>",
+	"cli.search.usage.example":  "Пример: gotickets search host:github.com created:>2025-01-01 -wip \"login bug\"",
+	"cli.search.usage.fields":   "Поля: title, url, host, num, id, tag, status, created. -слово исключает, OR объединяет условия.",
+	"cli.unknown_command":       "Неизвестная команда: %s",
+	"cli.unpin.summary":         "открепить тикеты",
+	"cli.usage":                 "Использование: gotickets [команда] [аргументы]",
+	"cli.usage.commands":        "Команды:",
+	"cli.usage.interactive":     "Без команды запускается интерактивный интерфейс.",
+	"cli.warning":               "Предупреждение: %v",
+
+	"config.defaults": "используются настройки по умолчанию: %v",
+
+	"file.malformed":   "ошибка в %s: %v",
+	"file.read_failed": "не удалось прочитать %s: %v",
+
+	"format.bad_regexp":     "неверное регулярное выражение: %v",
+	"format.missing_fields": "формат должен содержать url и title: %q",
+	"format.no_separator":   "в формате не указан разделитель: %q",
+	"format.regexp_groups":  "регулярное выражение должно содержать группы (?P<url>...) и (?P<title>...)",
+
+	"group.host":    "по сайту",
+	"group.none":    "без групп",
+	"group.project": "по проекту",
+
+	"help.actions":            "действия",
+	"help.all_none":           "все/ничего",
+	"help.apply":              "применить",
+	"help.apply_search":       "применить поиск",
+	"help.back":               "назад",
+	"help.back_or_clear":      "сбросить фильтр / назад",
+	"help.back_to_list":       "вернуться к списку",
+	"help.cancel":             "отмена",
+	"help.clear_selection":    "снять выделение",
+	"help.close_help":         "закрыть справку",
+	"help.continue":           "продолжить",
+	"help.continue_title":     "продолжить к названию",
+	"help.copy":               "копировать",
+	"help.delete":             "удалить",
+	"help.details":            "подробно",
+	"help.edit_title":         "изменить название",
+	"help.export":             "экспорт",
+	"help.filter":             "фильтр",
+	"help.force_quit":         "выход без вопросов",
+	"help.import":             "импортировать",
+	"help.keep_mine":          "оставить мои",
+	"help.mark":               "отметить",
+	"help.navigate":           "навигация",
+	"help.new_backup":         "новая копия",
+	"help.no_cancel":          "нет, отменить",
+	"help.open":               "открыть",
+	"help.page":               "страница",
+	"help.path_format":        "путь/формат",
+	"help.quick_filter":       "быстрый фильтр",
+	"help.reload":             "загрузить с диска",
+	"help.restore":            "восстановить",
+	"help.run":                "выполнить",
+	"help.save":               "сохранить",
+	"help.save_ticket":        "сохранить тикет",
+	"help.save_title":         "сохранить название",
+	"help.scroll":             "прокрутка",
+	"help.section.bulk":       "Действия с выбранными",
+	"help.section.detail":     "Подробности тикета",
+	"help.section.general":    "Общие",
+	"help.section.navigation": "Навигация",
+	"help.section.search":     "Поиск и фильтры",
+	"help.section.selection":  "Выбор нескольких тикетов",
+	"help.section.tickets":    "Тикеты",
+	"help.section.view":       "Вид и команды",
+	"help.select_all":         "выбрать все",
+	"help.show_in_list":       "перейти в списке",
+	"help.start_import":       "начать импорт",
+	"help.yes_delete":         "да, удалить",
+	"help.yes_restore":        "да, восстановить",
+
+	"i18n.unknown_language": "неизвестный язык %q (доступны: %s)",
+
+	"import.bad_format":        "неверный формат",
+	"import.cancelled":         "импорт отменен: %v",
+	"import.empty_fields":      "пустая ссылка или название",
+	"import.error_line":        "Строка %d: %s",
+	"import.failed":            "Импорт не выполнен: %v",
+	"import.open_failed":       "не удалось открыть файл: %v",
+	"import.read_failed":       "ошибка чтения данных: %v",
+	"import.result.added":      "Добавлено тикетов: %d",
+	"import.result.duplicates": "Дубликатов пропущено: %d",
+	"import.result.errors":     "Ошибок формата: %d",
+	"import.result.skipped":    "Пропущено вручную: %d",
+	"import.save_failed":       "импорт отменен, не удалось сохранить тикеты: %v",
+
+	"input.placeholder.import": "Введите путь к .txt файлу...",
+	"input.placeholder.search": "Поиск тикетов...",
+	"input.placeholder.text":   "Введите текст...",
+	"input.placeholder.title":  "Введите название тикета...",
+	"input.placeholder.url":    "Введите ссылку или ключ тикета (PROJ-123)...",
+
+	"key.space": "Пробел",
+
+	"keys.conflicts":            "конфликт клавиш: %s (используются клавиши набора)",
+	"keys.help.add":             "добавить",
+	"keys.help.back":            "назад",
+	"keys.help.backups":         "резервные копии",
+	"keys.help.bottom":          "в конец",
+	"keys.help.bulk":            "действия",
+	"keys.help.choose":          "выбрать",
+	"keys.help.clear_selection": "снять выделение",
+	"keys.help.confirm":         "да",
+	"keys.help.copy":            "копировать ссылку",
+	"keys.help.delete":          "удалить",
+	"keys.help.deny":            "нет",
+	"keys.help.details":         "подробно",
+	"keys.help.down":            "вниз",
+	"keys.help.filters":         "фильтры",
+	"keys.help.group":           "группировка",
+	"keys.help.help":            "справка",
+	"keys.help.import":          "импорт",
+	"keys.help.open":            "открыть",
+	"keys.help.page_down":       "след. страница",
+	"keys.help.page_up":         "пред. страница",
+	"keys.help.palette":         "команды",
+	"keys.help.paste":           "вставить ссылки",
+	"keys.help.pin":             "закрепить",
+	"keys.help.quit":            "выход",
+	"keys.help.recent":          "недавние",
+	"keys.help.refresh":         "обновить",
+	"keys.help.save":            "повторить сохранение",
+	"keys.help.save_filter":     "сохранить фильтр",
+	"keys.help.search":          "поиск",
+	"keys.help.select":          "отметить",
+	"keys.help.select_all":      "отметить все",
+	"keys.help.select_range":    "отметить диапазон",
+	"keys.help.sort":            "сортировка",
+	"keys.help.top":             "в начало",
+	"keys.help.up":              "вверх",
+	"keys.no_keys":              "для действия %q не заданы клавиши",
+	"keys.unknown_action":       "неизвестное действие %q",
+	"keys.unknown_preset":       "неизвестный набор клавиш %q",
+
+	"list.archived": "в архиве: %d",
+	"list.filter":   "Фильтр «%s»",
+	"list.grouping": "Группировка: %s",
+	"list.groups":   "Группы: %s",
+	"list.no_group": "Без группы",
+	"list.pinned":   "Закреплено",
+	"list.shown":    "Показано: %d из %d",
+	"list.sort":     "Сортировка: %s",
+	"list.total":    "Всего тикетов: %d",
+
+	"palette.add":               "Добавить тикет",
+	"palette.backups":           "Резервные копии",
+	"palette.bulk":              "Действия с выбранными",
+	"palette.clear_selection":   "Снять выделение",
+	"palette.details":           "Подробности тикета",
+	"palette.export":            "Экспорт в файл",
+	"palette.filters":           "Сохраненные фильтры",
+	"palette.group":             "Группировка: %s",
+	"palette.help":              "Справка по клавишам",
+	"palette.import":            "Импорт из файла",
+	"palette.nothing_to_export": "Нет тикетов для экспорта",
+	"palette.paste":             "Вставить ссылки из буфера",
+	"palette.pin":               "Закрепить или открепить тикет",
+	"palette.placeholder":       "Начните вводить название команды...",
+	"palette.quit":              "Выход",
+	"palette.recent":            "Недавние тикеты",
+	"palette.refresh":           "Обновить список",
+	"palette.save":              "Повторить сохранение",
+	"palette.save_filter":       "Сохранить фильтр",
+	"palette.search":            "Поиск",
+	"palette.select_all":        "Отметить все",
+	"palette.sort":              "Сортировка: %s",
+	"palette.theme":             "Тема: %s",
+
+	"paste.empty":       "буфер обмена пуст",
+	"paste.read_failed": "Не удалось прочитать буфер обмена: %v",
+
+	"recent.empty": "Недавно открытых или скопированных тикетов нет",
+
+	"reload.check_failed": "Не удалось загрузить изменения tickets.json, тикеты в памяти сохранены: %v",
+	"reload.done":         "Тикеты обновлены: файл изменен другой программой",
+	"reload.kept":         "Оставлены изменения в памяти, они заменят файл при сохранении (%s)",
+
+	"saved.apply_failed": "Фильтр %q не применен: %s",
+	"saved.deleted":      "Фильтр %q удален",
+	"saved.missing":      "Нет сохраненного фильтра %d (%s - список фильтров)",
+	"saved.no_search":    "Сначала задайте поиск (%s), затем сохраните его",
+	"saved.placeholder":  "Введите название фильтра...",
+	"saved.save_failed":  "Не удалось сохранить фильтр: %v",
+	"saved.saved":        "Фильтр %q сохранен",
+
+	"search.nothing_found": "Ничего не найдено",
+
+	"size.bytes": "%d Б",
+	"size.kb":    "%.1f КБ",
+	"size.mb":    "%.1f МБ",
+
+	"sort.added":        "по добавлению",
+	"sort.created_asc":  "сначала старые",
+	"sort.created_desc": "сначала новые",
+	"sort.host":         "по сайту",
+	"sort.last_opened":  "недавно открытые",
+	"sort.number":       "по номеру",
+	"sort.recent":       "недавно использованные",
+	"sort.status":       "по статусу",
+	"sort.title":        "по названию",
+	"sort.unknown":      "неизвестная сортировка %q (доступны: %s)",
+
+	"status.dirty":           "Есть несохраненные изменения (%s - сохранить)",
+	"status.nothing_to_save": "Нет несохраненных изменений",
+	"status.quit_unsaved":    "Есть несохраненные изменения: %s - повторить, %s - выйти без сохранения",
+	"status.save_failed":     "Не удалось сохранить тикеты: %v (%s - повторить)",
+	"status.saved":           "Изменения сохранены",
+
+	"storage.backup.corrupted":           "резервная копия %s повреждена: %v",
+	"storage.backup.create_failed":       "не удалось записать резервную копию: %v",
+	"storage.backup.delete_failed":       "не удалось удалить %s: %v",
+	"storage.backup.not_backup":          "%s не является резервной копией",
+	"storage.backup.not_found":           "резервная копия %s не найдена",
+	"storage.backup.read_failed":         "не удалось прочитать %s: %v",
+	"storage.backup.read_tickets_failed": "не удалось прочитать tickets.json для резервной копии: %v",
+	"storage.backup.remove_unsupported":  "файловая система не умеет удалять файлы",
+	"storage.backup.restore_failed":      "не удалось записать tickets.json: %v",
+	"storage.bulk.bad_tag":               "тег не может быть пустым или содержать пробелы",
+	"storage.bulk.none_found":            "ни одного из выбранных тикетов",
+	"storage.bulk.write_failed":          "не удалось записать %s: %v",
+	"storage.err.backup_failed":          "не удалось создать резервную копию",
+	"storage.err.backup_index":           "не удалось записать описание резервной копии",
+	"storage.err.duplicate_url":          "тикет с такой ссылкой уже существует",
+	"storage.err.empty_name":             "название не может быть пустым",
+	"storage.err.empty_title":            "название тикета не может быть пустым",
+	"storage.err.invalid_url":            "неверная ссылка",
+	"storage.err.not_found":              "тикет не найден",
+	"storage.query.bad_date":             "неверная дата %q (ожидается ГГГГ-ММ-ДД или ГГГГ-ММ)",
+	"storage.query.bad_id":               "id должен быть числом: %q",
+	"storage.query.bad_yes_no":           "ожидается yes или no: %q",
+	"storage.query.empty_value":          "пустое значение для поля %s",
+	"storage.query.or_placement":         "OR должен стоять между условиями",
+	"storage.query.unclosed_quote":       "незакрытая кавычка",
+	"storage.saved.empty_query":          "пустой поисковый запрос",
+	"storage.saved.filter":               "фильтр %q",
+	"storage.url.bad_scheme":             "схема %q не поддерживается (разрешены: %s)",
+	"storage.url.empty":                  "пустая ссылка",
+	"storage.url.no_host":                "в ссылке нет адреса сервера",
+	"storage.url.no_project":             "для номера %s не задан проект (default_project в config.json)",
+	"storage.url.no_scheme":              "не указана схема (например, https://)",
+	"storage.url.no_tracker":             "для ключа %s не настроен трекер (url_templates или tracker_base_url в config.json)",
+	"storage.url.spaces":                 "ссылка содержит пробелы",
+
+	"theme.no_color": "Задана переменная NO_COLOR, тема без цветов остается",
+	"theme.set":      "Тема: %s",
+	"theme.unknown":  "неизвестная тема %q (доступны: %s)",
+
+	"ticket.add_failed":      "Не удалось добавить тикет: %v",
+	"ticket.already_deleted": "Тикет уже удален",
+	"ticket.copied":          "Ссылка скопирована",
+	"ticket.copy_failed":     "Не удалось скопировать ссылку: %v",
+	"ticket.delete_failed":   "Тикет не удален: %v",
+	"ticket.open_failed":     "Не удалось открыть браузер: %v",
+	"ticket.pinned":          "Тикет закреплен",
+	"ticket.unpinned":        "Тикет откреплен",
+
+	"time.days_ago":    "%d дн назад",
+	"time.hours_ago":   "%d ч назад",
+	"time.just_now":    "только что",
+	"time.minutes_ago": "%d мин назад",
+
+	"ui.config_defaults":      "Используются настройки по умолчанию: %v",
+	"ui.keys_warning":         "Настройки клавиш: %v",
+	"ui.state_restore_failed": "Не удалось восстановить состояние: %v",
+
+	"view.add.title.header":       "Добавить новый тикет - Название",
+	"view.add.title.key":          "Ключ: %s",
+	"view.add.title.link":         "Ссылка: %s",
+	"view.add.title.prompt":       "Введите название тикета:",
+	"view.add.url.header":         "Добавить новый тикет - Ссылка",
+	"view.add.url.prompt":         "Введите ссылку:",
+	"view.backup_delete.header":   "🗑️  Удаление резервной копии",
+	"view.backup_delete.question": "Удалить эту резервную копию?",
+	"view.backup_delete.warning":  "Файл будет удален без возможности восстановления!",
+	"view.backups.count":          "Найдено резервных копий: %d",
+	"view.backups.empty":          "Резервные копии не найдены.",
+	"view.backups.export":         "Куда экспортировать %s:",
+	"view.backups.filter":         "Фильтр: ",
+	"view.backups.filtered":       "Показано %d из %d · фильтр: %s",
+	"view.backups.header":         "Резервные копии",
+	"view.backups.label":          "Метка новой резервной копии (можно оставить пустой):",
+	"view.backups.no_match":       "Нет резервных копий, подходящих под фильтр.",
+	"view.backups.tickets":        "тикетов: %d",
+	"view.backups.unreadable":     "файл поврежден",
+	"view.bulk.archive":           "в архив",
+	"view.bulk.copy":              "скопировать ссылки",
+	"view.bulk.export":            "экспорт в файл",
+	"view.bulk.header":            "Действия с выбранными тикетами (%d)",
+	"view.bulk.open":              "открыть все в браузере",
+	"view.bulk.prompt.export":     "Файл для экспорта (формат «ссылка - название»):",
+	"view.bulk.prompt.status":     "Статус для выбранных тикетов (пусто - сбросить):",
+	"view.bulk.prompt.tag":        "Тег для выбранных тикетов:",
+	"view.bulk.status":            "изменить статус",
+	"view.bulk.tag":               "добавить тег",
+	"view.bulk.unarchive":         "вернуть из архива",
+	"view.confirm.backup":         "Перед изменением будет создана одна резервная копия.",
+	"view.confirm.header":         "Подтверждение",
+	"view.confirm.more":           "... и еще %d",
+	"view.delete.header":          "Подтверждение удаления",
+	"view.delete.question":        "Вы уверены, что хотите удалить тикет?",
+	"view.delete.ticket":          "Тикет: #%d - %s",
+	"view.detail.archived":        "В архиве",
+	"view.detail.copied":          "Скопирован",
+	"view.detail.created":         "Создан",
+	"view.detail.key":             "Ключ",
+	"view.detail.link":            "Ссылка",
+	"view.detail.never":           "никогда",
+	"view.detail.not_found":       "Тикет не найден",
+	"view.detail.opened":          "Открыт",
+	"view.detail.pinned":          "Закреплен",
+	"view.detail.status":          "Статус",
+	"view.detail.tags":            "Теги",
+	"view.detail.usage":           "%s (%s), всего %d",
+	"view.detail.yes":             "да",
+	"view.help.header":            "Справка по клавишам",
+	"view.help.lines":             "строки %d-%d из %d",
+	"view.import.format":          "Формат строки (auto, 'url - title', 'title | url', tsv, re:<регулярное выражение>):",
+	"view.import.header":          "Импорт тикетов",
+	"view.import.path":            "Введите путь к .txt файлу:",
+	"view.import.result.errors":   "Ошибки:",
+	"view.import.result.header":   "Результат импорта",
+	"view.palette.count":          "Команд: %d",
+	"view.palette.empty":          "Нет подходящих команд.",
+	"view.palette.header":         "Команды",
+	"view.preview.duplicate":      "строка %d: %s - %s (дубликат)",
+	"view.preview.empty":          "Нет строк для импорта.",
+	"view.preview.header":         "Предпросмотр импорта",
+	"view.preview.invalid":        "строка %d: %s (%s)",
+	"view.preview.new":            "строка %d: %s - %s",
+	"view.preview.new_title":      "Новое название:",
+	"view.preview.summary":        "Будет добавлено: %d • Дубликатов: %d • Ошибок: %d",
+	"view.recent.header":          "Недавние тикеты",
+	"view.reload.header":          "🔄 Файл тикетов изменен",
+	"view.reload.question":        "Другая программа изменила tickets.json, а в памяти есть несохраненные изменения.",
+	"view.reload.warning":         "Загрузка с диска отменит несохраненные изменения!",
+	"view.restore.backup":         "Резервная копия: %s",
+	"view.restore.header":         "Подтверждение восстановления",
+	"view.restore.question":       "Вы уверены, что хотите восстановить из резервной копии?",
+	"view.restore.warning":        "ВНИМАНИЕ: Это заменит все текущие тикеты!",
+	"view.save_filter.header":     "Сохранить фильтр",
+	"view.save_filter.name":       "Название фильтра:",
+	"view.save_filter.query":      "Запрос: %s",
+	"view.saved.empty":            "Сохраненных фильтров нет. Задайте поиск (%s) и нажмите %s, чтобы сохранить его.",
+	"view.saved.header":           "Сохраненные фильтры",
+	"view.search.prompt":          "Поиск:",
+	"view.selected":               "Выбрано: %d",
+}
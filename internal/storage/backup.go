@@ -0,0 +1,267 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gotickets/internal/i18n"
+)
+
+// BackupOperation is the change that triggered a backup
+type BackupOperation string
+
+const (
+	BackupAdd    BackupOperation = "add"
+	BackupDelete BackupOperation = "delete"
+	BackupEdit   BackupOperation = "edit"
+	BackupImport BackupOperation = "import"
+	BackupManual BackupOperation = "manual"
+)
+
+var backupOperationLabels = map[BackupOperation]string{
+	BackupAdd:    "backup.op.add",
+	BackupDelete: "backup.op.delete",
+	BackupEdit:   "backup.op.edit",
+	BackupImport: "backup.op.import",
+	BackupManual: "backup.op.manual",
+}
+
+// Label returns the human-readable name of the operation. Backups made before
+// operations were recorded have none.
+func (o BackupOperation) Label() string {
+	if label, ok := backupOperationLabels[o]; ok {
+		return i18n.T(label)
+	}
+	return i18n.T("backup.op.unknown")
+}
+
+const (
+	backupPrefix     = "tickets_backup_"
+	backupSuffix     = ".json"
+	backupTimeLayout = "2006-01-02_15-04-05"
+	// backupIndexFile records the operation and label of each backup; the
+	// backups themselves stay plain copies of tickets.json
+	backupIndexFile = "backups.json"
+)
+
+// backupMeta is the entry of a backup in the backup index
+type backupMeta struct {
+	Operation BackupOperation `json:"operation"`
+	Label     string          `json:"label,omitempty"`
+}
+
+// BackupInfo describes a backup file
+type BackupInfo struct {
+	Name      string
+	CreatedAt time.Time
+	Size      int64
+	// Tickets is the number of tickets in the backup, -1 if it cannot be read
+	Tickets   int
+	Operation BackupOperation
+	Label     string
+}
+
+// CreateBackupUsing copies tickets.json to a new backup without recording an operation
+func CreateBackupUsing(fs FileSystem) error {
+	_, err := CreateBackup(fs, "", "")
+	return err
+}
+
+// CreateBackup copies tickets.json to a new backup and records the operation
+// that triggered it with an optional label. It returns the name of the backup,
+// or "" when there is no tickets file yet. If only recording the operation
+// fails, the backup exists and the error wraps ErrBackupIndex.
+func CreateBackup(fs FileSystem, operation BackupOperation, label string) (string, error) {
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return "", err
+	}
+	filePath := filepath.Join(dataDir, "tickets.json")
+	if _, err := fs.Stat(filePath); os.IsNotExist(err) {
+		return "", nil
+	}
+	data, err := fs.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("storage.backup.read_tickets_failed"), err)
+	}
+	name := newBackupName(fs, dataDir, time.Now())
+	if err := fs.WriteFile(filepath.Join(dataDir, name), data, 0644); err != nil {
+		return "", fmt.Errorf(i18n.T("storage.backup.create_failed"), err)
+	}
+	label = strings.TrimSpace(label)
+	if operation == "" && label == "" {
+		return name, nil
+	}
+	err = updateBackupIndex(fs, dataDir, func(index map[string]backupMeta) {
+		index[name] = backupMeta{Operation: operation, Label: label}
+	})
+	if err != nil {
+		return name, fmt.Errorf("%w: %v", ErrBackupIndex, err)
+	}
+	return name, nil
+}
+
+// backup backs up tickets.json before a change. Failing to record the
+// operation does not stop the change.
+func (ts *TicketStorage) backup(operation BackupOperation) error {
+	_, err := CreateBackup(ts.getFS(), operation, "")
+	if errors.Is(err, ErrBackupIndex) {
+		return nil
+	}
+	return err
+}
+
+// newBackupName names a backup after its creation time; backups made within
+// the same second get a numeric suffix instead of overwriting each other
+func newBackupName(fs FileSystem, dataDir string, now time.Time) string {
+	base := backupPrefix + now.Format(backupTimeLayout)
+	name := base + backupSuffix
+	for n := 2; ; n++ {
+		if _, err := fs.Stat(filepath.Join(dataDir, name)); os.IsNotExist(err) {
+			return name
+		}
+		name = fmt.Sprintf("%s_%d%s", base, n, backupSuffix)
+	}
+}
+
+// backupSequence returns the numeric suffix newBackupName gives to backups
+// made within the same second; the first one has none and counts as 1
+func backupSequence(name string) int {
+	stamp := strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupSuffix)
+	if len(stamp) <= len(backupTimeLayout)+1 {
+		return 1
+	}
+	n, err := strconv.Atoi(stamp[len(backupTimeLayout)+1:])
+	if err != nil {
+		return 1
+	}
+	return n
+}
+
+// isBackupName reports whether name is a backup file of the data directory
+func isBackupName(name string) bool {
+	return strings.HasPrefix(name, backupPrefix) && strings.HasSuffix(name, backupSuffix) &&
+		!strings.ContainsAny(name, `/\`)
+}
+
+// loadBackupIndex reads the backup index; a missing or broken index is empty
+func loadBackupIndex(fs FileSystem, dataDir string) map[string]backupMeta {
+	index := map[string]backupMeta{}
+	data, err := fs.ReadFile(filepath.Join(dataDir, backupIndexFile))
+	if err != nil {
+		return index
+	}
+	if err := json.Unmarshal(data, &index); err != nil || index == nil {
+		return map[string]backupMeta{}
+	}
+	return index
+}
+
+func updateBackupIndex(fs FileSystem, dataDir string, update func(map[string]backupMeta)) error {
+	index := loadBackupIndex(fs, dataDir)
+	update(index)
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(filepath.Join(dataDir, backupIndexFile), data, 0644)
+}
+
+// ListBackupInfos describes every backup, newest first
+func ListBackupInfos(fs FileSystem) ([]BackupInfo, error) {
+	names, err := ListBackupsUsing(fs)
+	if err != nil {
+		return nil, err
+	}
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return nil, err
+	}
+	index := loadBackupIndex(fs, dataDir)
+	backups := make([]BackupInfo, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dataDir, name)
+		info := BackupInfo{Name: name, Tickets: -1, Operation: index[name].Operation, Label: index[name].Label}
+		if stat, err := fs.Stat(path); err == nil {
+			info.Size = stat.Size()
+			info.CreatedAt = stat.ModTime()
+		}
+		stamp := strings.TrimPrefix(name, backupPrefix)
+		if len(stamp) >= len(backupTimeLayout) {
+			if created, err := time.ParseInLocation(backupTimeLayout, stamp[:len(backupTimeLayout)], time.Local); err == nil {
+				info.CreatedAt = created
+			}
+		}
+		if data, err := fs.ReadFile(path); err == nil {
+			var backup TicketStorage
+			if json.Unmarshal(data, &backup) == nil {
+				info.Tickets = len(backup.Tickets)
+			}
+		}
+		backups = append(backups, info)
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		if !backups[i].CreatedAt.Equal(backups[j].CreatedAt) {
+			return backups[i].CreatedAt.After(backups[j].CreatedAt)
+		}
+		return backupSequence(backups[i].Name) > backupSequence(backups[j].Name)
+	})
+	return backups, nil
+}
+
+// remover is implemented by file systems that can delete files
+type remover interface {
+	Remove(name string) error
+}
+
+// DeleteBackup removes a backup and its entry in the backup index. The file
+// system has to implement Remove.
+func DeleteBackup(fs FileSystem, name string) error {
+	if !isBackupName(name) {
+		return fmt.Errorf(i18n.T("storage.backup.not_backup"), name)
+	}
+	r, ok := fs.(remover)
+	if !ok {
+		return errors.New(i18n.T("storage.backup.remove_unsupported"))
+	}
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return err
+	}
+	if err := r.Remove(filepath.Join(dataDir, name)); err != nil {
+		return fmt.Errorf(i18n.T("storage.backup.delete_failed"), name, err)
+	}
+	index := loadBackupIndex(fs, dataDir)
+	if _, ok := index[name]; !ok {
+		return nil
+	}
+	return updateBackupIndex(fs, dataDir, func(index map[string]backupMeta) {
+		delete(index, name)
+	})
+}
+
+// ExportBackup copies a backup to path outside of the data directory
+func ExportBackup(fs FileSystem, name, path string) error {
+	if !isBackupName(name) {
+		return fmt.Errorf(i18n.T("storage.backup.not_backup"), name)
+	}
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return err
+	}
+	data, err := fs.ReadFile(filepath.Join(dataDir, name))
+	if err != nil {
+		return fmt.Errorf(i18n.T("storage.backup.read_failed"), name, err)
+	}
+	if err := fs.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf(i18n.T("storage.bulk.write_failed"), path, err)
+	}
+	return nil
+}
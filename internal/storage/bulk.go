@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+// ActiveTickets returns the tickets that are not archived
+func (ts *TicketStorage) ActiveTickets() []Ticket {
+	tickets := make([]Ticket, 0, len(ts.Tickets))
+	for _, ticket := range ts.Tickets {
+		if !ticket.Archived {
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets
+}
+
+// TicketsByID returns the tickets with the given IDs in storage order
+func (ts *TicketStorage) TicketsByID(ids []int) []Ticket {
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var tickets []Ticket
+	for _, ticket := range ts.Tickets {
+		if wanted[ticket.ID] {
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets
+}
+
+// updateTickets backs up the tickets once and applies update to every ticket
+// with one of the given IDs. It returns the number of tickets updated.
+func (ts *TicketStorage) updateTickets(ids []int, update func(*Ticket)) (int, error) {
+	tickets := ts.TicketsByID(ids)
+	if len(tickets) == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrNotFound, i18n.T("storage.bulk.none_found"))
+	}
+	if err := ts.backup(BackupEdit); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupFailed, err)
+	}
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	for i := range ts.Tickets {
+		if wanted[ts.Tickets[i].ID] {
+			update(&ts.Tickets[i])
+		}
+	}
+	return len(tickets), nil
+}
+
+// DeleteTickets removes several tickets after a single backup
+func (ts *TicketStorage) DeleteTickets(ids []int) (int, error) {
+	if len(ts.TicketsByID(ids)) == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrNotFound, i18n.T("storage.bulk.none_found"))
+	}
+	if err := ts.backup(BackupDelete); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupFailed, err)
+	}
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	kept := make([]Ticket, 0, len(ts.Tickets))
+	for _, ticket := range ts.Tickets {
+		if !wanted[ticket.ID] {
+			kept = append(kept, ticket)
+		}
+	}
+	deleted := len(ts.Tickets) - len(kept)
+	ts.Tickets = kept
+	return deleted, nil
+}
+
+// ArchiveTickets archives or unarchives several tickets after a single backup
+func (ts *TicketStorage) ArchiveTickets(ids []int, archived bool) (int, error) {
+	return ts.updateTickets(ids, func(t *Ticket) { t.Archived = archived })
+}
+
+// TagTickets adds a tag to several tickets after a single backup
+func (ts *TicketStorage) TagTickets(ids []int, tag string) (int, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || strings.ContainsAny(tag, " \t") {
+		return 0, errors.New(i18n.T("storage.bulk.bad_tag"))
+	}
+	return ts.updateTickets(ids, func(t *Ticket) {
+		for _, existing := range t.Tags {
+			if strings.EqualFold(existing, tag) {
+				return
+			}
+		}
+		t.Tags = append(t.Tags, tag)
+	})
+}
+
+// SetStatus sets the status of several tickets after a single backup; an empty status clears it
+func (ts *TicketStorage) SetStatus(ids []int, status string) (int, error) {
+	status = strings.TrimSpace(status)
+	return ts.updateTickets(ids, func(t *Ticket) { t.Status = status })
+}
+
+// ExportTickets writes tickets to a file in the "url - title" import format
+func (ts *TicketStorage) ExportTickets(path string, ids []int) (int, error) {
+	tickets := ts.TicketsByID(ids)
+	if len(tickets) == 0 {
+		return 0, fmt.Errorf("%w: %s", ErrNotFound, i18n.T("storage.bulk.none_found"))
+	}
+	var b strings.Builder
+	for _, ticket := range tickets {
+		fmt.Fprintf(&b, "%s - %s\n", ticket.URL, ticket.Title)
+	}
+	if err := ts.getFS().WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return 0, fmt.Errorf(i18n.T("storage.bulk.write_failed"), path, err)
+	}
+	return len(tickets), nil
+}
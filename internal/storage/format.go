@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+// ImportFormat describes how a single import line is split into a URL and a title.
+// Either Pattern (with named groups "url" and "title") or Separator is used.
+type ImportFormat struct {
+	Name       string
+	Separator  string
+	TitleFirst bool
+	Pattern    *regexp.Regexp
+}
+
+// builtinFormats are tried in order when the format is auto-detected
+var builtinFormats = []ImportFormat{
+	{Name: "url - title", Separator: " - "},
+	{Name: "title - url", Separator: " - ", TitleFirst: true},
+	{Name: `url\ttitle`, Separator: "\t"},
+	{Name: `title\turl`, Separator: "\t", TitleFirst: true},
+	{Name: "url | title", Separator: " | "},
+	{Name: "title | url", Separator: " | ", TitleFirst: true},
+	urlOnlyFormat,
+}
+
+// urlOnlyFormat matches lines that consist of a single link without a title
+var urlOnlyFormat = ImportFormat{Name: "url"}
+
+// ParseImportFormat parses a format spec. Supported specs:
+//
+//	auto (or empty)   detect the format for every line
+//	url               bare links, the title is derived from the link
+//	tsv               alias for "url<TAB>title"
+//	url - title       any separator between the words url and title, \t means tab
+//	re:<regexp>       regular expression with named groups url and title
+//
+// A nil format means auto-detection.
+func ParseImportFormat(spec string) (*ImportFormat, error) {
+	spec = strings.TrimSpace(spec)
+	name := spec
+	switch strings.ToLower(spec) {
+	case "", "auto":
+		return nil, nil
+	case "url":
+		format := urlOnlyFormat
+		return &format, nil
+	case "tsv":
+		spec = `url\ttitle`
+	}
+
+	if strings.HasPrefix(spec, "re:") {
+		re, err := regexp.Compile(strings.TrimPrefix(spec, "re:"))
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("format.bad_regexp"), err)
+		}
+		if re.SubexpIndex("url") < 0 || re.SubexpIndex("title") < 0 {
+			return nil, errors.New(i18n.T("format.regexp_groups"))
+		}
+		return &ImportFormat{Name: spec, Pattern: re}, nil
+	}
+
+	spec = strings.ReplaceAll(spec, `\t`, "\t")
+	lower := strings.ToLower(spec)
+	urlPos, titlePos := strings.Index(lower, "url"), strings.Index(lower, "title")
+	if urlPos < 0 || titlePos < 0 {
+		return nil, fmt.Errorf(i18n.T("format.missing_fields"), name)
+	}
+	format := &ImportFormat{Name: name}
+	if urlPos < titlePos {
+		format.Separator = spec[urlPos+len("url") : titlePos]
+	} else {
+		format.Separator = spec[titlePos+len("title") : urlPos]
+		format.TitleFirst = true
+	}
+	if format.Separator == "" {
+		return nil, fmt.Errorf(i18n.T("format.no_separator"), name)
+	}
+	return format, nil
+}
+
+// Split extracts the URL and title from a line. The separator is searched from
+// the title's far side, so titles that contain the separator themselves still parse.
+func (f ImportFormat) Split(line string) (url, title string, ok bool) {
+	if f.Pattern != nil {
+		matches := f.Pattern.FindStringSubmatch(line)
+		if matches == nil {
+			return "", "", false
+		}
+		url = matches[f.Pattern.SubexpIndex("url")]
+		title = matches[f.Pattern.SubexpIndex("title")]
+		return strings.TrimSpace(url), strings.TrimSpace(title), true
+	}
+	if f.Separator == "" {
+		url = strings.TrimSpace(line)
+		return url, titleFromURL(url), true
+	}
+
+	var idx int
+	if f.TitleFirst {
+		idx = strings.LastIndex(line, f.Separator)
+	} else {
+		idx = strings.Index(line, f.Separator)
+	}
+	if idx < 0 {
+		return "", "", false
+	}
+	first := strings.TrimSpace(line[:idx])
+	second := strings.TrimSpace(line[idx+len(f.Separator):])
+	if f.TitleFirst {
+		return second, first, true
+	}
+	return first, second, true
+}
+
+// detectFormat returns the first built-in format whose URL field looks like a link
+func detectFormat(line string) (url, title string, format *ImportFormat) {
+	for i := range builtinFormats {
+		u, t, ok := builtinFormats[i].Split(line)
+		if ok && looksLikeURL(u) {
+			return u, t, &builtinFormats[i]
+		}
+	}
+	return "", "", nil
+}
+
+// titleFromURL builds a placeholder title for links imported without one
+func titleFromURL(url string) string {
+	if _, rest, found := strings.Cut(url, "://"); found {
+		url = rest
+	}
+	return strings.TrimSuffix(url, "/")
+}
+
+// looksLikeURL reports whether s is a single token with a scheme
+func looksLikeURL(s string) bool {
+	if s == "" || strings.ContainsAny(s, " \t") {
+		return false
+	}
+	scheme, rest, found := strings.Cut(s, "://")
+	return found && scheme != "" && rest != ""
+}
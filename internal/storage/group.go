@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+// GroupMode is how the ticket list is split into groups
+type GroupMode string
+
+const (
+	GroupNone    GroupMode = ""
+	GroupHost    GroupMode = "host"
+	GroupProject GroupMode = "project"
+)
+
+// GroupModes lists the group modes in the order they are cycled through
+var GroupModes = []GroupMode{GroupNone, GroupHost, GroupProject}
+
+var groupLabels = map[GroupMode]string{
+	GroupNone:    "group.none",
+	GroupHost:    "group.host",
+	GroupProject: "group.project",
+}
+
+var urlProjectPattern = regexp.MustCompile(`\b([A-Z][A-Z0-9]*)-\d+\b`)
+
+// TicketGroup is a named run of tickets sharing a host or project
+type TicketGroup struct {
+	Name    string
+	Tickets []Ticket
+}
+
+// ParseGroupMode parses a group mode name; unknown names mean GroupNone
+func ParseGroupMode(name string) GroupMode {
+	for _, mode := range GroupModes {
+		if string(mode) == strings.ToLower(strings.TrimSpace(name)) {
+			return mode
+		}
+	}
+	return GroupNone
+}
+
+// Label returns the human-readable name of the group mode
+func (g GroupMode) Label() string {
+	return i18n.T(groupLabels[g])
+}
+
+// Next returns the group mode that follows g when cycling
+func (g GroupMode) Next() GroupMode {
+	for i, mode := range GroupModes {
+		if mode == g {
+			return GroupModes[(i+1)%len(GroupModes)]
+		}
+	}
+	return GroupNone
+}
+
+// TicketProject returns the project key of a ticket (PROJ for PROJ-123), if any
+func TicketProject(t Ticket) string {
+	if project, _, found := strings.Cut(t.Key, "-"); found {
+		return strings.ToUpper(project)
+	}
+	if matches := urlProjectPattern.FindStringSubmatch(t.URL); matches != nil {
+		return matches[1]
+	}
+	return ""
+}
+
+// GroupName returns the name of the group a ticket belongs to; empty means ungrouped
+func GroupName(t Ticket, mode GroupMode) string {
+	switch mode {
+	case GroupHost:
+		return ticketHost(t)
+	case GroupProject:
+		return TicketProject(t)
+	}
+	return ""
+}
+
+// GroupTickets splits tickets into groups sorted by name, keeping the ticket
+// order within each group. Tickets without a host or project come last.
+func GroupTickets(tickets []Ticket, mode GroupMode) []TicketGroup {
+	index := make(map[string]int)
+	var groups []TicketGroup
+	for _, ticket := range tickets {
+		name := GroupName(ticket, mode)
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, TicketGroup{Name: name})
+		}
+		groups[i].Tickets = append(groups[i].Tickets, ticket)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].Name == "") != (groups[j].Name == "") {
+			return groups[j].Name == ""
+		}
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
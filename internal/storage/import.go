@@ -0,0 +1,249 @@
+package storage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"gotickets/internal/i18n"
+)
+
+// maxImportLineLength bounds a single import line, so piped data with long lines still parses
+const maxImportLineLength = 1024 * 1024
+
+// ImportStatus describes what will happen to a parsed import line
+type ImportStatus int
+
+const (
+	ImportNew ImportStatus = iota
+	ImportDuplicate
+	ImportInvalid
+)
+
+// ImportCandidate is a single parsed line of an import file
+type ImportCandidate struct {
+	Line     int
+	URL      string
+	Key      string
+	Title    string
+	Status   ImportStatus
+	Error    string
+	Selected bool
+	// Format is the name of the line format the candidate was parsed with
+	Format string
+}
+
+// ImportPreview holds parsed import lines before anything is written
+type ImportPreview struct {
+	Candidates []ImportCandidate
+}
+
+type ImportResult struct {
+	Added      int
+	Duplicates int
+	Errors     int
+	Skipped    int
+	ErrorLines []string
+}
+
+// Toggle flips the selection of a candidate; duplicates and invalid lines stay unselected
+func (p *ImportPreview) Toggle(index int) {
+	if index < 0 || index >= len(p.Candidates) || p.Candidates[index].Status != ImportNew {
+		return
+	}
+	p.Candidates[index].Selected = !p.Candidates[index].Selected
+}
+
+// SetAll selects or deselects every new candidate
+func (p *ImportPreview) SetAll(selected bool) {
+	for i := range p.Candidates {
+		if p.Candidates[i].Status == ImportNew {
+			p.Candidates[i].Selected = selected
+		}
+	}
+}
+
+// SetTitle replaces the title of a candidate, ignoring empty values
+func (p *ImportPreview) SetTitle(index int, title string) {
+	title = strings.TrimSpace(title)
+	if index < 0 || index >= len(p.Candidates) || title == "" {
+		return
+	}
+	p.Candidates[index].Title = title
+}
+
+// Selected returns the number of candidates that will be imported
+func (p *ImportPreview) Selected() int {
+	count := 0
+	for _, c := range p.Candidates {
+		if c.Selected {
+			count++
+		}
+	}
+	return count
+}
+
+// FormatCounts returns how many lines matched each format
+func (p *ImportPreview) FormatCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, c := range p.Candidates {
+		if c.Format != "" {
+			counts[c.Format]++
+		}
+	}
+	return counts
+}
+
+// Summary returns the result the import would have if applied as is
+func (p *ImportPreview) Summary() *ImportResult {
+	result := &ImportResult{ErrorLines: make([]string, 0)}
+	for _, c := range p.Candidates {
+		switch {
+		case c.Status == ImportInvalid:
+			result.Errors++
+			result.ErrorLines = append(result.ErrorLines, i18n.T("import.error_line", c.Line, c.Error))
+		case c.Status == ImportDuplicate:
+			result.Duplicates++
+		case c.Selected:
+			result.Added++
+		default:
+			result.Skipped++
+		}
+	}
+	return result
+}
+
+// PreviewImportFile parses an import file without modifying the storage.
+// It is a thin wrapper over PreviewImport.
+func (ts *TicketStorage) PreviewImportFile(filePath string, format *ImportFormat) (*ImportPreview, error) {
+	f, err := ts.getFS().Open(filePath)
+	if err != nil {
+		return &ImportPreview{}, fmt.Errorf(i18n.T("import.open_failed"), err)
+	}
+	defer f.Close()
+	return ts.PreviewImport(f, format)
+}
+
+// PreviewImport parses import lines from any reader (a file, stdin, pasted text)
+// without modifying the storage. A nil format auto-detects the format of every line.
+func (ts *TicketStorage) PreviewImport(r io.Reader, format *ImportFormat) (*ImportPreview, error) {
+	preview := &ImportPreview{}
+	known := ts.urlIndex()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineLength)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		candidate := ImportCandidate{Line: lineNumber, Title: line}
+		var url, title string
+		var ok bool
+		if format != nil {
+			url, title, ok = format.Split(line)
+			candidate.Format = format.Name
+		} else {
+			var detected *ImportFormat
+			url, title, detected = detectFormat(line)
+			if ok = detected != nil; ok {
+				candidate.Format = detected.Name
+			}
+		}
+		switch {
+		case !ok:
+			candidate.Status = ImportInvalid
+			candidate.Error = i18n.T("import.bad_format")
+		case url == "" || title == "":
+			candidate.Status = ImportInvalid
+			candidate.Error = i18n.T("import.empty_fields")
+		default:
+			resolved, err := ts.policy.Resolve(url)
+			if err != nil {
+				candidate.Status = ImportInvalid
+				candidate.Error = err.Error()
+				break
+			}
+			candidate.URL = resolved.URL
+			candidate.Key = resolved.Key
+			candidate.Title = title
+			if known[candidate.URL] {
+				candidate.Status = ImportDuplicate
+			} else {
+				candidate.Selected = true
+				known[candidate.URL] = true
+			}
+		}
+		preview.Candidates = append(preview.Candidates, candidate)
+	}
+	if err := scanner.Err(); err != nil {
+		return preview, fmt.Errorf(i18n.T("import.read_failed"), err)
+	}
+	return preview, nil
+}
+
+// ApplyImport adds the selected candidates of a preview as a single transaction:
+// one backup up front, one save at the end, and no changes at all if either fails
+func (ts *TicketStorage) ApplyImport(preview *ImportPreview) (*ImportResult, error) {
+	result := preview.Summary()
+	result.Added = 0
+
+	known := ts.urlIndex()
+	nextID := ts.NextID
+	var batch []Ticket
+	now := time.Now()
+	for _, c := range preview.Candidates {
+		if !c.Selected || c.Status != ImportNew {
+			continue
+		}
+		if known[c.URL] {
+			result.Duplicates++
+			continue
+		}
+		known[c.URL] = true
+		batch = append(batch, Ticket{ID: nextID, Title: c.Title, URL: c.URL, Key: c.Key, CreatedAt: now})
+		nextID++
+	}
+	if len(batch) == 0 {
+		return result, nil
+	}
+
+	if err := ts.backup(BackupImport); err != nil {
+		return result, fmt.Errorf(i18n.T("import.cancelled"), err)
+	}
+
+	prevTickets, prevNextID := ts.Tickets, ts.NextID
+	tickets := make([]Ticket, 0, len(ts.Tickets)+len(batch))
+	tickets = append(tickets, ts.Tickets...)
+	ts.Tickets = append(tickets, batch...)
+	ts.NextID = nextID
+	if err := ts.Save(); err != nil {
+		ts.Tickets, ts.NextID = prevTickets, prevNextID
+		return result, fmt.Errorf(i18n.T("import.save_failed"), err)
+	}
+	result.Added = len(batch)
+	return result, nil
+}
+
+// Import parses import lines from a reader and applies every new line
+func (ts *TicketStorage) Import(r io.Reader, format *ImportFormat) (*ImportResult, error) {
+	preview, err := ts.PreviewImport(r, format)
+	if err != nil {
+		return &ImportResult{ErrorLines: make([]string, 0)}, err
+	}
+	return ts.ApplyImport(preview)
+}
+
+// ImportFromFile imports a file, auto-detecting the line format.
+// It is a thin wrapper over Import.
+func (ts *TicketStorage) ImportFromFile(filePath string) (*ImportResult, error) {
+	f, err := ts.getFS().Open(filePath)
+	if err != nil {
+		return &ImportResult{ErrorLines: make([]string, 0)}, fmt.Errorf(i18n.T("import.open_failed"), err)
+	}
+	defer f.Close()
+	return ts.Import(f, nil)
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SetPinned pins or unpins a ticket
+func (ts *TicketStorage) SetPinned(id int, pinned bool) error {
+	for i := range ts.Tickets {
+		if ts.Tickets[i].ID == id {
+			ts.Tickets[i].Pinned = pinned
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: #%d", ErrNotFound, id)
+}
+
+// PinnedTickets returns the active pinned tickets in storage order
+func (ts *TicketStorage) PinnedTickets() []Ticket {
+	var tickets []Ticket
+	for _, ticket := range ts.Tickets {
+		if ticket.Pinned && !ticket.Archived {
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets
+}
+
+// FindTicket looks a ticket up by ID, ticket number or key (PROJ-123), or link
+func (ts *TicketStorage) FindTicket(ref string) (Ticket, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.Atoi(ref); err == nil {
+		for _, ticket := range ts.Tickets {
+			if ticket.ID == id {
+				return ticket, nil
+			}
+		}
+	}
+	for _, ticket := range ts.Tickets {
+		if strings.EqualFold(ticket.ExtractTicketNumber(), ref) || ticket.URL == ref {
+			return ticket, nil
+		}
+	}
+	return Ticket{}, fmt.Errorf("%w: %s", ErrNotFound, ref)
+}
@@ -0,0 +1,345 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"gotickets/internal/i18n"
+)
+
+// Query is a parsed search query: groups of terms joined by OR,
+// every term within a group has to match
+type Query struct {
+	Groups [][]QueryTerm
+}
+
+// QueryTerm is a single condition of a query, e.g. host:github.com or -wip
+type QueryTerm struct {
+	// Field is empty for free text, otherwise one of queryFields
+	Field  string
+	Value  string
+	Negate bool
+	// Phrase is set for quoted text
+	Phrase bool
+
+	// Date range for created: comparisons, [From, To)
+	From, To time.Time
+}
+
+// queryFields lists the supported field prefixes
+var queryFields = map[string]bool{
+	"title": true, "url": true, "host": true, "num": true,
+	"id": true, "tag": true, "status": true, "created": true,
+	"archived": true,
+}
+
+// ParseQuery parses a query such as
+//
+//	host:github.com created:>2025-01-01 -wip "login bug" OR tag:urgent
+//
+// Supported fields: title, url, host, num, id, tag, status, created and
+// archived (yes or no; archived tickets are skipped unless the field is used).
+// created accepts dates (2025-01-31) or months (2025-01) with an optional
+// comparison: >, >=, <, <= or =.
+func ParseQuery(input string) (*Query, error) {
+	tokens, err := tokenizeQuery(input)
+	if err != nil {
+		return nil, err
+	}
+	q := &Query{}
+	var group []QueryTerm
+	for i, tok := range tokens {
+		if tok.text == "OR" && !tok.quoted {
+			if len(group) == 0 || i == len(tokens)-1 {
+				return nil, errors.New(i18n.T("storage.query.or_placement"))
+			}
+			q.Groups = append(q.Groups, group)
+			group = nil
+			continue
+		}
+		term, err := parseTerm(tok)
+		if err != nil {
+			return nil, err
+		}
+		group = append(group, term)
+	}
+	if len(group) > 0 {
+		q.Groups = append(q.Groups, group)
+	}
+	return q, nil
+}
+
+type queryToken struct {
+	text   string
+	quoted bool
+	// bare is the length of text before the first quote; only that part
+	// can hold a negation or a field prefix
+	bare int
+}
+
+// tokenizeQuery splits on whitespace, keeping quoted parts together
+// (both "login bug" and title:"login bug")
+func tokenizeQuery(input string) ([]queryToken, error) {
+	var tokens []queryToken
+	var current strings.Builder
+	inQuotes, quoted, bare := false, false, 0
+	flush := func() {
+		if !quoted {
+			bare = current.Len()
+		}
+		if current.Len() > 0 || quoted {
+			tokens = append(tokens, queryToken{text: current.String(), quoted: quoted, bare: bare})
+		}
+		current.Reset()
+		quoted = false
+	}
+	for _, r := range input {
+		switch {
+		case r == '"':
+			if !quoted {
+				bare = current.Len()
+			}
+			inQuotes = !inQuotes
+			quoted = true
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, errors.New(i18n.T("storage.query.unclosed_quote"))
+	}
+	flush()
+	return tokens, nil
+}
+
+// parseTerm parses a token into a term. Quoted text is never a negation or a
+// field, and a prefix that is not a known field is free text (12:30, re: login).
+func parseTerm(tok queryToken) (QueryTerm, error) {
+	text, bare := tok.text, tok.text[:tok.bare]
+	term := QueryTerm{Phrase: tok.quoted}
+	if strings.HasPrefix(bare, "-") && len(text) > 1 {
+		term.Negate = true
+		text, bare = text[1:], bare[1:]
+	}
+
+	// Links typed as free text are not field prefixes
+	field, value, found := strings.Cut(text, ":")
+	field = strings.ToLower(field)
+	if found && len(field) < len(bare) && queryFields[field] && !strings.HasPrefix(value, "//") {
+		if value == "" {
+			return term, fmt.Errorf(i18n.T("storage.query.empty_value"), field)
+		}
+		term.Field, term.Value = field, value
+		switch field {
+		case "id":
+			if _, err := strconv.Atoi(value); err != nil {
+				return term, fmt.Errorf(i18n.T("storage.query.bad_id"), value)
+			}
+		case "archived":
+			if _, err := parseYesNo(value); err != nil {
+				return term, err
+			}
+		case "created":
+			from, to, err := parseDateRange(value)
+			if err != nil {
+				return term, err
+			}
+			term.From, term.To = from, to
+		}
+		return term, nil
+	}
+
+	term.Value = text
+	return term, nil
+}
+
+// parseYesNo parses the value of a boolean field
+func parseYesNo(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "yes", "true", "1", "да":
+		return true, nil
+	case "no", "false", "0", "нет":
+		return false, nil
+	}
+	return false, fmt.Errorf(i18n.T("storage.query.bad_yes_no"), value)
+}
+
+// parseDateRange turns ">2025-01-01" and friends into a half-open time range
+func parseDateRange(value string) (time.Time, time.Time, error) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(value, candidate) {
+			op = candidate
+			value = value[len(candidate):]
+			break
+		}
+	}
+
+	var start, end time.Time
+	if day, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		start, end = day, day.AddDate(0, 0, 1)
+	} else if month, err := time.ParseInLocation("2006-01", value, time.Local); err == nil {
+		start, end = month, month.AddDate(0, 1, 0)
+	} else {
+		return time.Time{}, time.Time{}, fmt.Errorf(i18n.T("storage.query.bad_date"), value)
+	}
+
+	switch op {
+	case ">":
+		return end, time.Time{}, nil
+	case ">=":
+		return start, time.Time{}, nil
+	case "<":
+		return time.Time{}, start, nil
+	case "<=":
+		return time.Time{}, end, nil
+	default:
+		return start, end, nil
+	}
+}
+
+// HasField reports whether any term of the query uses the field
+func (q *Query) HasField(field string) bool {
+	if q == nil {
+		return false
+	}
+	for _, group := range q.Groups {
+		for _, term := range group {
+			if term.Field == field {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Match reports whether the ticket satisfies the query. An empty query matches
+// every active ticket; archived tickets only match queries using archived:.
+func (q *Query) Match(t Ticket) bool {
+	if t.Archived && !q.HasField("archived") {
+		return false
+	}
+	if q == nil || len(q.Groups) == 0 {
+		return true
+	}
+	for _, group := range q.Groups {
+		matched := true
+		for _, term := range group {
+			if term.match(t) == term.Negate {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPlain reports whether the query is just free text without fields,
+// phrases, negation or OR, so it can be ranked by fuzzy search
+func (q *Query) IsPlain() bool {
+	if q == nil || len(q.Groups) != 1 {
+		return false
+	}
+	for _, term := range q.Groups[0] {
+		if term.Field != "" || term.Negate || term.Phrase {
+			return false
+		}
+	}
+	return true
+}
+
+// PlainText joins the free text terms of a plain query
+func (q *Query) PlainText() string {
+	if !q.IsPlain() {
+		return ""
+	}
+	words := make([]string, len(q.Groups[0]))
+	for i, term := range q.Groups[0] {
+		words[i] = term.Value
+	}
+	return strings.Join(words, " ")
+}
+
+func (term QueryTerm) match(t Ticket) bool {
+	value := strings.ToLower(term.Value)
+	contains := func(s string) bool { return strings.Contains(strings.ToLower(s), value) }
+
+	switch term.Field {
+	case "":
+		return contains(t.Title) || contains(t.URL) || contains(t.ExtractTicketNumber())
+	case "title":
+		return contains(t.Title)
+	case "url":
+		return contains(t.URL)
+	case "host":
+		host := ticketHost(t)
+		return host == value || strings.HasSuffix(host, "."+value)
+	case "num":
+		return strings.EqualFold(t.ExtractTicketNumber(), term.Value)
+	case "id":
+		id, _ := strconv.Atoi(term.Value)
+		return t.ID == id
+	case "tag":
+		for _, tag := range t.Tags {
+			if strings.EqualFold(tag, term.Value) {
+				return true
+			}
+		}
+		return false
+	case "status":
+		return strings.EqualFold(t.Status, term.Value)
+	case "archived":
+		archived, _ := parseYesNo(term.Value)
+		return t.Archived == archived
+	case "created":
+		if !term.From.IsZero() && t.CreatedAt.Before(term.From) {
+			return false
+		}
+		if !term.To.IsZero() && !t.CreatedAt.Before(term.To) {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// ticketHost returns the lower-cased host of the ticket's URL without port
+func ticketHost(t Ticket) string {
+	u, err := neturl.Parse(t.URL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// Query returns the tickets matching a query string. Plain text queries are
+// ranked by fuzzy search; structured queries keep insertion order.
+func (ts *TicketStorage) Query(input string) ([]Ticket, error) {
+	q, err := ParseQuery(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(q.Groups) == 0 {
+		return ts.ActiveTickets(), nil
+	}
+	if q.IsPlain() {
+		return ts.Search(q.PlainText()), nil
+	}
+	var results []Ticket
+	for _, ticket := range ts.Tickets {
+		if q.Match(ticket) {
+			results = append(results, ticket)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+// SavedSearch is a named search query stored together with the tickets
+type SavedSearch struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+// SaveSearch stores a query under a name, replacing a saved search with the
+// same name (case-insensitive). The query has to parse.
+func (ts *TicketStorage) SaveSearch(name, query string) error {
+	name = strings.TrimSpace(name)
+	query = strings.TrimSpace(query)
+	if name == "" {
+		return ErrEmptyName
+	}
+	if query == "" {
+		return errors.New(i18n.T("storage.saved.empty_query"))
+	}
+	if _, err := ParseQuery(query); err != nil {
+		return err
+	}
+	for i, saved := range ts.SavedSearches {
+		if strings.EqualFold(saved.Name, name) {
+			ts.SavedSearches[i] = SavedSearch{Name: name, Query: query}
+			return nil
+		}
+	}
+	ts.SavedSearches = append(ts.SavedSearches, SavedSearch{Name: name, Query: query})
+	return nil
+}
+
+// DeleteSavedSearch removes a saved search by name
+func (ts *TicketStorage) DeleteSavedSearch(name string) error {
+	for i, saved := range ts.SavedSearches {
+		if strings.EqualFold(saved.Name, name) {
+			ts.SavedSearches = append(ts.SavedSearches[:i], ts.SavedSearches[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotFound, i18n.T("storage.saved.filter", name))
+}
+
+// FindSavedSearch looks up a saved search by name
+func (ts *TicketStorage) FindSavedSearch(name string) (SavedSearch, bool) {
+	for _, saved := range ts.SavedSearches {
+		if strings.EqualFold(saved.Name, name) {
+			return saved, true
+		}
+	}
+	return SavedSearch{}, false
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/sahilm/fuzzy"
+)
+
+// SearchField identifies the ticket field a search match was found in
+type SearchField int
+
+const (
+	FieldTitle SearchField = iota
+	FieldURL
+	FieldNumber
+)
+
+// SearchMatch is a ticket found by a fuzzy search
+type SearchMatch struct {
+	Ticket Ticket
+	Score  int
+	Field  SearchField
+	// MatchedIndexes are byte offsets of the matched characters in the field
+	MatchedIndexes []int
+}
+
+// ticketFields is a fuzzy.Source over one field of a ticket slice
+type ticketFields struct {
+	tickets []Ticket
+	field   SearchField
+}
+
+func (f ticketFields) Len() int { return len(f.tickets) }
+
+func (f ticketFields) String(i int) string {
+	switch f.field {
+	case FieldURL:
+		return f.tickets[i].URL
+	case FieldNumber:
+		return f.tickets[i].ExtractTicketNumber()
+	default:
+		return f.tickets[i].Title
+	}
+}
+
+// SearchMatches fuzzy-matches the query against title, URL and ticket number
+// and returns the matches ordered by score, best first. For every ticket only
+// the best scoring field is kept. Archived tickets are skipped.
+func (ts *TicketStorage) SearchMatches(query string) []SearchMatch {
+	if query == "" {
+		return nil
+	}
+	best := make(map[int]SearchMatch)
+	for _, field := range []SearchField{FieldTitle, FieldNumber, FieldURL} {
+		for _, m := range fuzzy.FindFromNoSort(query, ticketFields{tickets: ts.Tickets, field: field}) {
+			if ts.Tickets[m.Index].Archived {
+				continue
+			}
+			if prev, ok := best[m.Index]; ok && prev.Score >= m.Score {
+				continue
+			}
+			best[m.Index] = SearchMatch{
+				Ticket:         ts.Tickets[m.Index],
+				Score:          m.Score,
+				Field:          field,
+				MatchedIndexes: append([]int(nil), m.MatchedIndexes...),
+			}
+		}
+	}
+
+	indexes := make([]int, 0, len(best))
+	for i := range best {
+		indexes = append(indexes, i)
+	}
+	// Higher score first; equal scores keep insertion order
+	sort.Slice(indexes, func(a, b int) bool {
+		sa, sb := best[indexes[a]].Score, best[indexes[b]].Score
+		if sa != sb {
+			return sa > sb
+		}
+		return indexes[a] < indexes[b]
+	})
+	results := make([]SearchMatch, len(indexes))
+	for i, idx := range indexes {
+		results[i] = best[idx]
+	}
+	return results
+}
+
+// Search returns the tickets fuzzy-matching the query, best matches first.
+// An empty query returns all active tickets in insertion order.
+func (ts *TicketStorage) Search(query string) []Ticket {
+	if query == "" {
+		return ts.ActiveTickets()
+	}
+	matches := ts.SearchMatches(query)
+	results := make([]Ticket, len(matches))
+	for i, m := range matches {
+		results[i] = m.Ticket
+	}
+	return results
+}
@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gotickets/internal/i18n"
+)
+
+// SortMode is the order tickets are listed in
+type SortMode string
+
+const (
+	SortAdded       SortMode = "added"
+	SortCreatedAsc  SortMode = "created"
+	SortCreatedDesc SortMode = "-created"
+	SortTitle       SortMode = "title"
+	SortNumber      SortMode = "number"
+	SortHost        SortMode = "host"
+	SortLastOpened  SortMode = "opened"
+	SortRecent      SortMode = "recent"
+	SortStatus      SortMode = "status"
+)
+
+// SortModes lists the sort modes in the order they are cycled through
+var SortModes = []SortMode{
+	SortAdded, SortCreatedDesc, SortCreatedAsc, SortTitle, SortNumber, SortHost, SortLastOpened, SortRecent, SortStatus,
+}
+
+var sortLabels = map[SortMode]string{
+	SortAdded:       "sort.added",
+	SortCreatedAsc:  "sort.created_asc",
+	SortCreatedDesc: "sort.created_desc",
+	SortTitle:       "sort.title",
+	SortNumber:      "sort.number",
+	SortHost:        "sort.host",
+	SortLastOpened:  "sort.last_opened",
+	SortRecent:      "sort.recent",
+	SortStatus:      "sort.status",
+}
+
+var lastNumberPattern = regexp.MustCompile(`(\d+)\D*$`)
+
+// ParseSortMode parses a sort mode name; an empty name means SortAdded
+func ParseSortMode(name string) (SortMode, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return SortAdded, nil
+	}
+	for _, mode := range SortModes {
+		if string(mode) == name {
+			return mode, nil
+		}
+	}
+	names := make([]string, len(SortModes))
+	for i, mode := range SortModes {
+		names[i] = string(mode)
+	}
+	return SortAdded, fmt.Errorf(i18n.T("sort.unknown"), name, strings.Join(names, ", "))
+}
+
+// Label returns the human-readable name of the sort mode
+func (s SortMode) Label() string {
+	if label, ok := sortLabels[s]; ok {
+		return i18n.T(label)
+	}
+	return i18n.T(sortLabels[SortAdded])
+}
+
+// Next returns the sort mode that follows s when cycling
+func (s SortMode) Next() SortMode {
+	for i, mode := range SortModes {
+		if mode == s {
+			return SortModes[(i+1)%len(SortModes)]
+		}
+	}
+	return SortAdded
+}
+
+// SortTickets returns a sorted copy of tickets. Ties keep their original order.
+func SortTickets(tickets []Ticket, mode SortMode) []Ticket {
+	sorted := make([]Ticket, len(tickets))
+	copy(sorted, tickets)
+	if less := ticketLess(mode); less != nil {
+		sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	}
+	return sorted
+}
+
+func ticketLess(mode SortMode) func(a, b Ticket) bool {
+	switch mode {
+	case SortCreatedAsc:
+		return func(a, b Ticket) bool { return a.CreatedAt.Before(b.CreatedAt) }
+	case SortCreatedDesc:
+		return func(a, b Ticket) bool { return a.CreatedAt.After(b.CreatedAt) }
+	case SortTitle:
+		return func(a, b Ticket) bool { return strings.ToLower(a.Title) < strings.ToLower(b.Title) }
+	case SortNumber:
+		return func(a, b Ticket) bool {
+			na, okA := ticketNumberValue(a)
+			nb, okB := ticketNumberValue(b)
+			if okA != okB {
+				return okA
+			}
+			return na < nb
+		}
+	case SortHost:
+		return func(a, b Ticket) bool { return ticketHost(a) < ticketHost(b) }
+	case SortLastOpened:
+		// Never opened tickets go last
+		return func(a, b Ticket) bool { return openedAt(a).After(openedAt(b)) }
+	case SortRecent:
+		return func(a, b Ticket) bool { return a.LastUsedAt().After(b.LastUsedAt()) }
+	case SortStatus:
+		// Tickets without a status go last
+		return func(a, b Ticket) bool {
+			if (a.Status == "") != (b.Status == "") {
+				return a.Status != ""
+			}
+			return strings.ToLower(a.Status) < strings.ToLower(b.Status)
+		}
+	}
+	return nil
+}
+
+// ticketNumberValue returns the numeric part of the ticket number, so 9 sorts before 10
+func ticketNumberValue(t Ticket) (int64, bool) {
+	matches := lastNumberPattern.FindStringSubmatch(t.ExtractTicketNumber())
+	if matches == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(matches[1], 10, 64)
+	return n, err == nil
+}
+
+func openedAt(t Ticket) time.Time {
+	if t.LastOpenedAt == nil {
+		return time.Time{}
+	}
+	return *t.LastOpenedAt
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gotickets/internal/i18n"
+)
+
+// StateFileName is the name of the UI state file inside the data directory
+const StateFileName = "state.json"
+
+// UIState is what the interface restores on the next start
+type UIState struct {
+	// Query is the last applied search query
+	Query string `json:"query,omitempty"`
+	// SavedSearch is the name of the saved filter the query came from
+	SavedSearch string `json:"saved_search,omitempty"`
+	// SelectedID is the ticket under the cursor
+	SelectedID int `json:"selected_id,omitempty"`
+	// Sort is the name of the list sort mode
+	Sort string `json:"sort,omitempty"`
+	// Group is the name of the group mode, Collapsed the collapsed group names
+	Group     string   `json:"group,omitempty"`
+	Collapsed []string `json:"collapsed,omitempty"`
+}
+
+// LoadState reads the UI state file. A missing file yields an empty state;
+// a malformed file yields an empty state together with an error.
+func LoadState(fs FileSystem) (*UIState, error) {
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return &UIState{}, err
+	}
+	data, err := fs.ReadFile(filepath.Join(dataDir, StateFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &UIState{}, nil
+		}
+		return &UIState{}, fmt.Errorf(i18n.T("file.read_failed"), StateFileName, err)
+	}
+	state := &UIState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return &UIState{}, fmt.Errorf(i18n.T("file.malformed"), StateFileName, err)
+	}
+	return state, nil
+}
+
+// SaveState writes the UI state file
+func SaveState(fs FileSystem, state *UIState) error {
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return err
+	}
+	if err := fs.MkdirAll(dataDir, 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(filepath.Join(dataDir, StateFileName), data, 0644)
+}
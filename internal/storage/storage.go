@@ -1,7 +1,7 @@
 package storage
 
 import (
-	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -9,6 +9,8 @@ import (
 	"regexp"
 	"strings"
 	"time"
+
+	"gotickets/internal/i18n"
 )
 
 // FileSystem abstracts filesystem interactions for easier testing
@@ -36,37 +38,72 @@ func (fs *RealFileSystem) WriteFile(filename string, data []byte, perm os.FileMo
 func (fs *RealFileSystem) ReadDir(dirname string) ([]os.DirEntry, error) { return os.ReadDir(dirname) }
 func (fs *RealFileSystem) Stat(name string) (os.FileInfo, error)         { return os.Stat(name) }
 func (fs *RealFileSystem) Open(name string) (*os.File, error)            { return os.Open(name) }
+func (fs *RealFileSystem) Remove(name string) error                      { return os.Remove(name) }
+
+// Errors returned by ticket operations; use errors.Is to check them
+var (
+	ErrDuplicateURL error = i18n.Error("storage.err.duplicate_url")
+	ErrNotFound     error = i18n.Error("storage.err.not_found")
+	ErrInvalidURL   error = i18n.Error("storage.err.invalid_url")
+	ErrEmptyTitle   error = i18n.Error("storage.err.empty_title")
+	ErrBackupFailed error = i18n.Error("storage.err.backup_failed")
+	ErrEmptyName    error = i18n.Error("storage.err.empty_name")
+	ErrBackupIndex  error = i18n.Error("storage.err.backup_index")
+)
 
 type Ticket struct {
-	ID        int       `json:"id"`
-	Title     string    `json:"title"`
-	URL       string    `json:"url"`
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// Key is the tracker key (PROJ-123) the ticket was added with, if any
+	Key string `json:"key,omitempty"`
+	// Tags and Status are free-form labels used by search queries
+	Tags      []string  `json:"tags,omitempty"`
+	Status    string    `json:"status,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
+	// Pinned tickets are listed above the rest
+	Pinned bool `json:"pinned,omitempty"`
+	// Archived tickets are hidden from the list unless a query asks for them
+	Archived bool `json:"archived,omitempty"`
+	// LastOpenedAt is when the ticket was last opened in the browser
+	LastOpenedAt *time.Time `json:"last_opened_at,omitempty"`
+	OpenCount    int        `json:"open_count,omitempty"`
+	// LastCopiedAt is when the ticket link was last copied to the clipboard
+	LastCopiedAt *time.Time `json:"last_copied_at,omitempty"`
+	CopyCount    int        `json:"copy_count,omitempty"`
 }
 
 // FilterValue implements bubbles list.Item interface
 func (t Ticket) FilterValue() string { return t.Title + " " + t.URL }
 
-// ExtractTicketNumber tries multiple strategies to get a ticket number from URL
+// Patterns used by ExtractTicketNumber, in order of preference
+var (
+	jiraNumberPattern     = regexp.MustCompile(`[A-Z]+-(\d+)`)
+	pathNumberPattern     = regexp.MustCompile(`.*[^/]/(\d+)(?:[/?#].*)?$`)
+	trailingNumberPattern = regexp.MustCompile(`/(\d+)(?:[/?#].*)?$`)
+	issueParamPattern     = regexp.MustCompile(`(?:issue|ticket|task)(?:s)?[/=](\d+)`)
+	longNumberPattern     = regexp.MustCompile(`(\d{6,})`)
+)
+
+// ExtractTicketNumber returns the tracker key if known, otherwise tries
+// multiple strategies to get a ticket number from URL
 func (t Ticket) ExtractTicketNumber() string {
-	re := regexp.MustCompile(`[A-Z]+-(\d+)`)
-	if matches := re.FindStringSubmatch(t.URL); len(matches) > 1 {
+	if t.Key != "" {
+		return t.Key
+	}
+	if matches := jiraNumberPattern.FindStringSubmatch(t.URL); len(matches) > 1 {
 		return matches[1]
 	}
-	re = regexp.MustCompile(`.*[^/]/(\d+)(?:[/?#].*)?$`)
-	if matches := re.FindStringSubmatch(t.URL); len(matches) > 1 {
+	if matches := pathNumberPattern.FindStringSubmatch(t.URL); len(matches) > 1 {
 		return matches[1]
 	}
-	re = regexp.MustCompile(`/(\d+)(?:[/?#].*)?$`)
-	if matches := re.FindStringSubmatch(t.URL); len(matches) > 1 {
+	if matches := trailingNumberPattern.FindStringSubmatch(t.URL); len(matches) > 1 {
 		return matches[1]
 	}
-	re = regexp.MustCompile(`(?:issue|ticket|task)(?:s)?[/=](\d+)`)
-	if matches := re.FindStringSubmatch(strings.ToLower(t.URL)); len(matches) > 1 {
+	if matches := issueParamPattern.FindStringSubmatch(strings.ToLower(t.URL)); len(matches) > 1 {
 		return matches[1]
 	}
-	re = regexp.MustCompile(`(\d{6,})`)
-	if matches := re.FindStringSubmatch(t.URL); len(matches) > 1 {
+	if matches := longNumberPattern.FindStringSubmatch(t.URL); len(matches) > 1 {
 		return matches[1]
 	}
 	return fmt.Sprintf("%06d", t.ID)
@@ -80,14 +117,10 @@ func (t Ticket) GetDescription() string { return t.URL }
 type TicketStorage struct {
 	Tickets []Ticket `json:"tickets"`
 	NextID  int      `json:"next_id"`
-	fs      FileSystem
-}
-
-type ImportResult struct {
-	Added      int
-	Duplicates int
-	Errors     int
-	ErrorLines []string
+	// SavedSearches are named queries applied from the filter picker
+	SavedSearches []SavedSearch `json:"saved_searches,omitempty"`
+	fs            FileSystem
+	policy        URLPolicy
 }
 
 func NewTicketStorage(fs FileSystem) *TicketStorage { return &TicketStorage{NextID: 1, fs: fs} }
@@ -99,62 +132,84 @@ func (ts *TicketStorage) getFS() FileSystem {
 	return ts.fs
 }
 
-func CreateBackupUsing(fs FileSystem) error {
+// FileSystem returns the file system the storage reads and writes through
+func (ts *TicketStorage) FileSystem() FileSystem { return ts.getFS() }
+
+// DataDir returns the directory that holds tickets, backups and configuration
+func DataDir(fs FileSystem) (string, error) {
 	homeDir, err := fs.UserHomeDir()
 	if err != nil {
-		return err
-	}
-	dataDir := filepath.Join(homeDir, ".gotickets")
-	filePath := filepath.Join(dataDir, "tickets.json")
-	if _, err := fs.Stat(filePath); os.IsNotExist(err) {
-		return nil
+		return "", err
 	}
-	timestamp := time.Now().Format("2006-01-02_15-04-05")
-	backupPath := filepath.Join(dataDir, fmt.Sprintf("tickets_backup_%s.json", timestamp))
-	data, err := fs.ReadFile(filePath)
+	return filepath.Join(homeDir, ".gotickets"), nil
+}
+
+// ResolveNewURL resolves a link or ticket key with the storage's URL policy and
+// checks that no ticket uses the link yet. It returns ErrInvalidURL or ErrDuplicateURL.
+func (ts *TicketStorage) ResolveNewURL(raw string) (ResolvedURL, error) {
+	resolved, err := ts.policy.Resolve(raw)
 	if err != nil {
-		return fmt.Errorf("failed to read tickets file for backup: %v", err)
+		return ResolvedURL{}, err
 	}
-	if err := fs.WriteFile(backupPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to create backup: %v", err)
+	if ts.HasTicketWithURL(resolved.URL) {
+		return ResolvedURL{}, ErrDuplicateURL
 	}
-	return nil
+	return resolved, nil
 }
 
-func (ts *TicketStorage) AddTicket(title, url string) {
-	if err := CreateBackupUsing(ts.getFS()); err != nil {
-		fmt.Printf("Warning: failed to create backup: %v\n", err)
-	}
-	ticket := Ticket{ID: ts.NextID, Title: title, URL: url, CreatedAt: time.Now()}
-	ts.Tickets = append(ts.Tickets, ticket)
-	ts.NextID++
+// SetURLPolicy sets how ticket links are validated and normalized
+func (ts *TicketStorage) SetURLPolicy(policy URLPolicy) {
+	ts.policy = policy
 }
 
-func (ts *TicketStorage) Search(query string) []Ticket {
-	if query == "" {
-		return ts.Tickets
+// AddTicket validates the ticket, backs up the tickets file and appends the ticket.
+// ref is a link or a ticket key that the URL policy expands into a link.
+// Nothing is added if validation or the backup fails.
+func (ts *TicketStorage) AddTicket(title, ref string) (Ticket, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return Ticket{}, ErrEmptyTitle
+	}
+	resolved, err := ts.ResolveNewURL(ref)
+	if err != nil {
+		return Ticket{}, err
 	}
-	var results []Ticket
-	q := strings.ToLower(query)
-	for _, ticket := range ts.Tickets {
-		if strings.Contains(strings.ToLower(ticket.Title), q) || strings.Contains(strings.ToLower(ticket.URL), q) {
-			results = append(results, ticket)
-		}
+	if err := ts.backup(BackupAdd); err != nil {
+		return Ticket{}, fmt.Errorf("%w: %v", ErrBackupFailed, err)
 	}
-	return results
+	ticket := Ticket{ID: ts.NextID, Title: title, URL: resolved.URL, Key: resolved.Key, CreatedAt: time.Now()}
+	ts.Tickets = append(ts.Tickets, ticket)
+	ts.NextID++
+	return ticket, nil
 }
 
-func (ts *TicketStorage) DeleteTicket(id int) bool {
-	if err := CreateBackupUsing(ts.getFS()); err != nil {
-		fmt.Printf("Warning: failed to create backup: %v\n", err)
-	}
+// DeleteTicket backs up the tickets file and removes the ticket with the given ID.
+// It returns ErrNotFound for unknown IDs; nothing is removed if the backup fails.
+func (ts *TicketStorage) DeleteTicket(id int) error {
+	index := -1
 	for i, ticket := range ts.Tickets {
 		if ticket.ID == id {
-			ts.Tickets = append(ts.Tickets[:i], ts.Tickets[i+1:]...)
-			return true
+			index = i
+			break
 		}
 	}
-	return false
+	if index < 0 {
+		return fmt.Errorf("%w: #%d", ErrNotFound, id)
+	}
+	if err := ts.backup(BackupDelete); err != nil {
+		return fmt.Errorf("%w: %v", ErrBackupFailed, err)
+	}
+	ts.Tickets = append(ts.Tickets[:index], ts.Tickets[index+1:]...)
+	return nil
+}
+
+// urlIndex returns the set of URLs already present in the storage
+func (ts *TicketStorage) urlIndex() map[string]bool {
+	index := make(map[string]bool, len(ts.Tickets))
+	for _, ticket := range ts.Tickets {
+		index[ticket.URL] = true
+	}
+	return index
 }
 
 func (ts *TicketStorage) HasTicketWithURL(url string) bool {
@@ -166,50 +221,6 @@ func (ts *TicketStorage) HasTicketWithURL(url string) bool {
 	return false
 }
 
-func (ts *TicketStorage) ImportFromFile(filePath string) (*ImportResult, error) {
-	if err := CreateBackupUsing(ts.getFS()); err != nil {
-		fmt.Printf("Warning: failed to create backup: %v\n", err)
-	}
-	result := &ImportResult{ErrorLines: make([]string, 0)}
-	f, err := ts.getFS().Open(filePath)
-	if err != nil {
-		return result, fmt.Errorf("не удалось открыть файл: %v", err)
-	}
-	defer f.Close()
-	scanner := bufio.NewScanner(f)
-	lineNumber := 0
-	for scanner.Scan() {
-		lineNumber++
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-		parts := strings.SplitN(line, " - ", 2)
-		if len(parts) != 2 {
-			result.Errors++
-			result.ErrorLines = append(result.ErrorLines, fmt.Sprintf("Строка %d: неверный формат", lineNumber))
-			continue
-		}
-		url := strings.TrimSpace(parts[0])
-		title := strings.TrimSpace(parts[1])
-		if url == "" || title == "" {
-			result.Errors++
-			result.ErrorLines = append(result.ErrorLines, fmt.Sprintf("Строка %d: пустая ссылка или название", lineNumber))
-			continue
-		}
-		if ts.HasTicketWithURL(url) {
-			result.Duplicates++
-			continue
-		}
-		ts.AddTicket(title, url)
-		result.Added++
-	}
-	if err := scanner.Err(); err != nil {
-		return result, fmt.Errorf("ошибка чтения файла: %v", err)
-	}
-	return result, nil
-}
-
 func (ts *TicketStorage) Save() error {
 	fs := ts.getFS()
 	homeDir, err := fs.UserHomeDir()
@@ -255,6 +266,53 @@ func LoadTicketsFromPathWithFS(fs FileSystem, filePath string) (*TicketStorage,
 	return &storage, nil
 }
 
+// TicketsFile returns the path of tickets.json
+func TicketsFile(fs FileSystem) (string, error) {
+	dataDir, err := DataDir(fs)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dataDir, "tickets.json"), nil
+}
+
+// LoadChanged returns the tickets of tickets.json if it holds something else
+// than the storage would save, and nil otherwise. A missing file or one that is
+// not valid JSON yet (still being written) is not reported as a change; valid
+// JSON that is not a ticket storage is an error.
+func (ts *TicketStorage) LoadChanged() (*TicketStorage, error) {
+	fs := ts.getFS()
+	filePath, err := TicketsFile(fs)
+	if err != nil {
+		return nil, err
+	}
+	data, err := fs.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, nil
+	}
+	current, err := json.MarshalIndent(ts, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(data, current) {
+		return nil, nil
+	}
+	var changed TicketStorage
+	if err := json.Unmarshal(data, &changed); err != nil {
+		return nil, err
+	}
+	if changed.NextID == 0 {
+		changed.NextID = 1
+	}
+	changed.fs = fs
+	return &changed, nil
+}
+
 func ListBackupsUsing(fs FileSystem) ([]string, error) {
 	homeDir, err := fs.UserHomeDir()
 	if err != nil {
@@ -283,18 +341,18 @@ func RestoreFromBackupUsing(fs FileSystem, backupName string) error {
 	backupPath := filepath.Join(dataDir, backupName)
 	filePath := filepath.Join(dataDir, "tickets.json")
 	if _, err := fs.Stat(backupPath); os.IsNotExist(err) {
-		return fmt.Errorf("backup file not found: %s", backupName)
+		return fmt.Errorf(i18n.T("storage.backup.not_found"), backupName)
 	}
 	data, err := fs.ReadFile(backupPath)
 	if err != nil {
-		return fmt.Errorf("failed to read backup file: %v", err)
+		return fmt.Errorf(i18n.T("storage.backup.read_failed"), backupName, err)
 	}
 	var storage TicketStorage
 	if err := json.Unmarshal(data, &storage); err != nil {
-		return fmt.Errorf("backup file is corrupted: %v", err)
+		return fmt.Errorf(i18n.T("storage.backup.corrupted"), backupName, err)
 	}
 	if err := fs.WriteFile(filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to restore from backup: %v", err)
+		return fmt.Errorf(i18n.T("storage.backup.restore_failed"), err)
 	}
 	return nil
 }
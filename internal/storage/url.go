@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"fmt"
+	neturl "net/url"
+	"regexp"
+	"strings"
+
+	"gotickets/internal/i18n"
+)
+
+// DefaultAllowedSchemes are the URL schemes accepted when none are configured
+var DefaultAllowedSchemes = []string{"http", "https"}
+
+var (
+	ticketKeyPattern    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*-\d+$`)
+	ticketNumberPattern = regexp.MustCompile(`^\d+$`)
+	bareHostPattern     = regexp.MustCompile(`(?i)^(localhost|[a-z0-9-]+(\.[a-z0-9-]+)+)(:\d+)?([/?#].*)?$`)
+)
+
+// URLPolicy controls how ticket links are validated and normalized
+type URLPolicy struct {
+	// AllowedSchemes lists accepted schemes; empty means DefaultAllowedSchemes
+	AllowedSchemes []string
+	// TrackerBaseURL expands ticket keys like PROJ-123. The key is substituted
+	// for {key} or appended to the base when there is no placeholder.
+	TrackerBaseURL string
+	// URLTemplates maps a project key to a link template with the placeholders
+	// {key}, {project} and {number}; it takes precedence over TrackerBaseURL
+	URLTemplates map[string]string
+	// DefaultProject is used to expand bare ticket numbers like 1234
+	DefaultProject string
+}
+
+// ResolvedURL is a normalized ticket link together with its ticket key, if known
+type ResolvedURL struct {
+	URL string
+	Key string
+}
+
+func (p URLPolicy) schemes() []string {
+	if len(p.AllowedSchemes) == 0 {
+		return DefaultAllowedSchemes
+	}
+	return p.AllowedSchemes
+}
+
+// Normalize trims the input, expands ticket keys, prefixes bare hosts with
+// https:// and checks the scheme and host. Errors wrap ErrInvalidURL.
+func (p URLPolicy) Normalize(raw string) (string, error) {
+	resolved, err := p.Resolve(raw)
+	return resolved.URL, err
+}
+
+// Resolve normalizes a link like Normalize and also accepts ticket keys
+// (PROJ-123) and bare numbers (123, with DefaultProject). The key is reported
+// for expanded keys and for links that match one of the URL templates.
+func (p URLPolicy) Resolve(raw string) (ResolvedURL, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return ResolvedURL{}, fmt.Errorf("%w: %s", ErrInvalidURL, i18n.T("storage.url.empty"))
+	}
+	if strings.ContainsAny(value, " \t\r\n") {
+		return ResolvedURL{}, fmt.Errorf("%w: %s", ErrInvalidURL, i18n.T("storage.url.spaces"))
+	}
+
+	if ticketNumberPattern.MatchString(value) {
+		if p.DefaultProject == "" {
+			return ResolvedURL{}, fmt.Errorf("%w: %s", ErrInvalidURL, i18n.T("storage.url.no_project", value))
+		}
+		value = p.DefaultProject + "-" + value
+	}
+	if ticketKeyPattern.MatchString(value) {
+		return p.expandKey(value)
+	}
+	url, err := p.normalizeLink(value)
+	if err != nil {
+		return ResolvedURL{}, err
+	}
+	return ResolvedURL{URL: url, Key: p.keyFromURL(url)}, nil
+}
+
+func (p URLPolicy) normalizeLink(value string) (string, error) {
+	if !strings.Contains(value, "://") && bareHostPattern.MatchString(value) {
+		value = "https://" + value
+	}
+
+	u, err := neturl.Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidURL, err)
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if scheme == "" {
+		return "", fmt.Errorf("%w: %s", ErrInvalidURL, i18n.T("storage.url.no_scheme"))
+	}
+	allowed := false
+	for _, s := range p.schemes() {
+		if strings.EqualFold(s, scheme) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return "", fmt.Errorf("%w: %s", ErrInvalidURL,
+			i18n.T("storage.url.bad_scheme", scheme, strings.Join(p.schemes(), ", ")))
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%w: %s", ErrInvalidURL, i18n.T("storage.url.no_host"))
+	}
+	// Keep the link as typed apart from the scheme, so duplicates still compare equal
+	return scheme + value[len(u.Scheme):], nil
+}
+
+// template returns the link template for a project, falling back to TrackerBaseURL
+func (p URLPolicy) template(project string) string {
+	for name, tmpl := range p.URLTemplates {
+		if strings.EqualFold(name, project) {
+			return tmpl
+		}
+	}
+	base := strings.TrimSpace(p.TrackerBaseURL)
+	if base == "" || strings.Contains(base, "{key}") {
+		return base
+	}
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	return base + "{key}"
+}
+
+func (p URLPolicy) expandKey(key string) (ResolvedURL, error) {
+	key = strings.ToUpper(key)
+	project, number, _ := strings.Cut(key, "-")
+	tmpl := p.template(project)
+	if tmpl == "" {
+		return ResolvedURL{}, fmt.Errorf("%w: %s", ErrInvalidURL, i18n.T("storage.url.no_tracker", key))
+	}
+	link := strings.NewReplacer("{key}", key, "{project}", project, "{number}", number).Replace(tmpl)
+	url, err := p.normalizeLink(link)
+	if err != nil {
+		return ResolvedURL{}, err
+	}
+	return ResolvedURL{URL: url, Key: key}, nil
+}
+
+// keyFromURL finds the ticket key of a link produced by one of the templates
+func (p URLPolicy) keyFromURL(url string) string {
+	for project, tmpl := range p.URLTemplates {
+		if key := matchTemplate(tmpl, url, project); key != "" {
+			return key
+		}
+	}
+	if base := p.template(""); base != "" {
+		return matchTemplate(base, url, "")
+	}
+	return ""
+}
+
+// matchTemplate reverses a link template and returns the key it was built from.
+// Templates that only contain {number} take the project from their config entry.
+func matchTemplate(tmpl, url, project string) string {
+	var pattern strings.Builder
+	pattern.WriteString("(?i)^")
+	rest := tmpl
+	for rest != "" {
+		start := strings.Index(rest, "{")
+		end := strings.Index(rest, "}")
+		if start < 0 || end < start {
+			pattern.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		pattern.WriteString(regexp.QuoteMeta(rest[:start]))
+		switch rest[start : end+1] {
+		case "{key}":
+			pattern.WriteString(`(?P<key>[A-Za-z][A-Za-z0-9]*-\d+)`)
+		case "{project}":
+			pattern.WriteString(`(?P<project>[A-Za-z][A-Za-z0-9]*)`)
+		case "{number}":
+			pattern.WriteString(`(?P<number>\d+)`)
+		default:
+			pattern.WriteString(regexp.QuoteMeta(rest[start : end+1]))
+		}
+		rest = rest[end+1:]
+	}
+	pattern.WriteString(`/?$`)
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return ""
+	}
+	matches := re.FindStringSubmatch(url)
+	if matches == nil {
+		return ""
+	}
+	if i := re.SubexpIndex("key"); i >= 0 {
+		return strings.ToUpper(matches[i])
+	}
+	n := re.SubexpIndex("number")
+	if n < 0 {
+		return ""
+	}
+	if i := re.SubexpIndex("project"); i >= 0 {
+		project = matches[i]
+	}
+	if project == "" {
+		return ""
+	}
+	return strings.ToUpper(project) + "-" + matches[n]
+}
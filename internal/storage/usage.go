@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"sort"
+	"time"
+)
+
+// LastUsedAt returns when the ticket was last opened or copied; zero if never
+func (t Ticket) LastUsedAt() time.Time {
+	used := openedAt(t)
+	if t.LastCopiedAt != nil && t.LastCopiedAt.After(used) {
+		used = *t.LastCopiedAt
+	}
+	return used
+}
+
+// MarkOpened records that the ticket was opened in the browser
+func (ts *TicketStorage) MarkOpened(id int) error {
+	return ts.markUsed(id, func(t *Ticket, now time.Time) {
+		t.LastOpenedAt = &now
+		t.OpenCount++
+	})
+}
+
+// MarkCopied records that the ticket link was copied to the clipboard
+func (ts *TicketStorage) MarkCopied(id int) error {
+	return ts.markUsed(id, func(t *Ticket, now time.Time) {
+		t.LastCopiedAt = &now
+		t.CopyCount++
+	})
+}
+
+func (ts *TicketStorage) markUsed(id int, mark func(*Ticket, time.Time)) error {
+	for i := range ts.Tickets {
+		if ts.Tickets[i].ID == id {
+			mark(&ts.Tickets[i], time.Now())
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// RecentTickets returns up to limit active tickets that were opened or
+// copied, most recently used first. A limit of 0 means no limit.
+func (ts *TicketStorage) RecentTickets(limit int) []Ticket {
+	var tickets []Ticket
+	for _, ticket := range ts.Tickets {
+		if !ticket.Archived && !ticket.LastUsedAt().IsZero() {
+			tickets = append(tickets, ticket)
+		}
+	}
+	sort.SliceStable(tickets, func(i, j int) bool {
+		return tickets[i].LastUsedAt().After(tickets[j].LastUsedAt())
+	})
+	if limit > 0 && len(tickets) > limit {
+		tickets = tickets[:limit]
+	}
+	return tickets
+}
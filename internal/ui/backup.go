@@ -1,44 +1,306 @@
 package ui
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"strings"
 
-// HandleBackups handles backup list navigation
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// backupInputAction is what the text input of the backup browser asks for
+type backupInputAction int
+
+const (
+	backupExport backupInputAction = iota
+	backupCreate
+)
+
+// backupItem is a backup in the backup browser
+type backupItem struct {
+	storage.BackupInfo
+}
+
+// Title shows when the backup was made and its label
+func (b backupItem) Title() string {
+	title := b.CreatedAt.Format("2006-01-02 15:04:05")
+	if b.Label != "" {
+		title += "  " + b.Label
+	}
+	return title
+}
+
+// Description shows the operation that triggered the backup, its size and ticket count
+func (b backupItem) Description() string {
+	tickets := i18n.T("view.backups.tickets", b.Tickets)
+	if b.Tickets < 0 {
+		tickets = i18n.T("view.backups.unreadable")
+	}
+	return strings.Join([]string{b.Operation.Label(), formatSize(b.Size), tickets}, " · ")
+}
+
+// FilterValue lets the filter match the date, the label, the operation and the file name
+func (b backupItem) FilterValue() string {
+	return b.Title() + " " + b.Description() + " " + b.Name
+}
+
+// formatSize renders a file size in bytes, kilobytes or megabytes
+func formatSize(size int64) string {
+	switch {
+	case size < 1024:
+		return i18n.T("size.bytes", size)
+	case size < 1024*1024:
+		return i18n.T("size.kb", float64(size)/1024)
+	default:
+		return i18n.T("size.mb", float64(size)/(1024*1024))
+	}
+}
+
+// newBackupList creates the list of the backup browser. Its title, status bar
+// and help are drawn by renderBackupsView.
+func newBackupList(keys KeyMap, styles Styles) list.Model {
+	l := list.New(nil, list.NewDefaultDelegate(), 80, 24)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetShowHelp(false)
+	l.SetShowFilter(false)
+	l.FilterInput.Prompt = i18n.T("view.backups.filter")
+	l.KeyMap = keys.backupListKeyMap()
+	styleBackupList(&l, styles)
+	return l
+}
+
+// styleBackupList applies the colors of the theme to the rows and the filter
+// input of the backup browser
+func styleBackupList(l *list.Model, styles Styles) {
+	l.SetDelegate(newBackupDelegate(styles))
+	l.FilterInput.PromptStyle = styles.Key
+	l.FilterInput.Cursor.Style = styles.Key
+}
+
+// newBackupDelegate renders a backup on two lines in the colors of the theme
+func newBackupDelegate(styles Styles) list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+	d.Styles.SelectedTitle = styles.Selected.Bold(true).Padding(0, 0, 0, 2)
+	d.Styles.SelectedDesc = styles.Selected.Padding(0, 0, 0, 2)
+	d.Styles.FilterMatch = styles.Match
+	return d
+}
+
+// selectedBackup returns the backup under the cursor
+func (m Model) selectedBackup() (backupItem, bool) {
+	item, ok := m.backupList.SelectedItem().(backupItem)
+	return item, ok
+}
+
+// loadBackups fills the backup browser, newest first, keeping the filter.
+// The named backup is selected if it is listed.
+func (m *Model) loadBackups(selectName string) error {
+	backups, err := storage.ListBackupInfos(m.storage.FileSystem())
+	if err != nil {
+		return err
+	}
+	items := make([]list.Item, len(backups))
+	for i, backup := range backups {
+		items[i] = backupItem{backup}
+	}
+	filter := ""
+	if m.backupList.IsFiltered() {
+		filter = m.backupList.FilterValue()
+	}
+	m.backupList.ResetFilter()
+	m.backupList.SetItems(items)
+	if filter != "" {
+		m.backupList.SetFilterText(filter)
+	}
+	for i, item := range m.backupList.VisibleItems() {
+		if item.(backupItem).Name == selectName {
+			m.backupList.Select(i)
+			break
+		}
+	}
+	return nil
+}
+
+// handleBackups opens the backup browser
+func (m Model) handleBackups() (Model, tea.Cmd) {
+	newModel := m
+	newModel.backupList.ResetFilter()
+	if err := newModel.loadBackups(""); err != nil {
+		return newModel, newModel.notifyError(i18n.T("backup.list_failed", err))
+	}
+	newModel.backupList.Select(0)
+	newModel.SetViewMode(ViewBackups)
+	return newModel, nil
+}
+
+// HandleBackups handles the backup browser: the list moves, pages and filters,
+// the letters act on the selected backup
 func (m Model) HandleBackups(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
 	newModel := m
 
-	switch msg.String() {
-	case "ctrl+c", "q":
+	if isForceQuit(msg) {
+		return newModel, tea.Quit
+	}
+	if m.backupList.SettingFilter() {
+		newModel.backupList, cmd = newModel.backupList.Update(msg)
+		return newModel, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		if m.backupList.IsFiltered() {
+			newModel.backupList.ResetFilter()
+			return newModel, nil
+		}
 		newModel.SetViewMode(ViewList)
-		newModel.selectedBackupIndex = -1
 		return newModel, nil
-	case "up", "k":
-		if len(newModel.backups) > 0 {
-			if newModel.selectedBackupIndex > 0 {
-				newModel.selectedBackupIndex--
-			} else {
-				newModel.selectedBackupIndex = len(newModel.backups) - 1
-			}
+	case key.Matches(msg, m.keys.Help):
+		return newModel.showHelp()
+	case key.Matches(msg, m.keys.Choose):
+		return newModel.askRestore()
+	}
+
+	switch msg.String() {
+	case "d":
+		if backup, ok := m.selectedBackup(); ok {
+			newModel.backupToDelete = backup.Name
+			newModel.SetViewMode(ViewConfirmBackupDelete)
 		}
 		return newModel, nil
-	case "down", "j":
-		if len(newModel.backups) > 0 {
-			if newModel.selectedBackupIndex < len(newModel.backups)-1 {
-				newModel.selectedBackupIndex++
-			} else {
-				newModel.selectedBackupIndex = 0
-			}
+	case "e":
+		if _, ok := m.selectedBackup(); ok {
+			return newModel.askBackupInput(backupExport, i18n.T("backup.placeholder.export"))
 		}
 		return newModel, nil
+	case "n":
+		return newModel.askBackupInput(backupCreate, i18n.T("backup.placeholder.label"))
+	}
+
+	newModel.backupList, cmd = newModel.backupList.Update(msg)
+	return newModel, cmd
+}
+
+// askRestore asks to restore the selected backup
+func (m Model) askRestore() (Model, tea.Cmd) {
+	newModel := m
+	if backup, ok := m.selectedBackup(); ok {
+		newModel.backupToRestore = backup.Name
+		newModel.SetViewMode(ViewConfirmRestore)
+	}
+	return newModel, nil
+}
+
+// askBackupInput asks for the export path or the label of a new backup
+func (m Model) askBackupInput(action backupInputAction, placeholder string) (Model, tea.Cmd) {
+	newModel := m
+	newModel.backupInput = action
+	newModel.textInput.SetValue("")
+	newModel.textInput.Placeholder = placeholder
+	newModel.textInput.Focus()
+	newModel.SetViewMode(ViewBackupInput)
+	return newModel, nil
+}
+
+// HandleBackupInput handles the export path and the label of a new backup
+func (m Model) HandleBackupInput(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	newModel := m
+
+	switch msg.String() {
+	case "ctrl+c":
+		return newModel, tea.Quit
+	case "esc":
+		newModel.ClearTextInput()
+		newModel.SetViewMode(ViewBackups)
+		return newModel, nil
 	case "enter":
-		if len(newModel.backups) > 0 && newModel.selectedBackupIndex >= 0 && newModel.selectedBackupIndex < len(newModel.backups) {
-			newModel.backupToRestore = newModel.backups[newModel.selectedBackupIndex]
-			newModel.SetViewMode(ViewConfirmRestore)
+		value := strings.TrimSpace(m.textInput.Value())
+		if m.backupInput == backupExport {
+			if value == "" {
+				return newModel, nil
+			}
+			return newModel.exportBackup(value)
 		}
+		return newModel.createBackup(value)
+	}
+
+	newModel.textInput, cmd = newModel.textInput.Update(msg)
+	return newModel, cmd
+}
+
+// exportBackup copies the selected backup to path
+func (m Model) exportBackup(path string) (Model, tea.Cmd) {
+	newModel := m
+	backup, ok := m.selectedBackup()
+	if !ok {
 		return newModel, nil
-	case "esc":
-		newModel.SetViewMode(ViewList)
-		newModel.selectedBackupIndex = -1
+	}
+	if err := storage.ExportBackup(m.storage.FileSystem(), backup.Name, path); err != nil {
+		// Keep the entered path so the user can fix it
+		return newModel, newModel.notifyError(i18n.T("backup.export_failed", err))
+	}
+	newModel.ClearTextInput()
+	newModel.SetViewMode(ViewBackups)
+	return newModel, newModel.notifyInfo(i18n.T("backup.exported", backup.Name, path))
+}
+
+// createBackup backs up the tickets as they are on disk under a label
+func (m Model) createBackup(label string) (Model, tea.Cmd) {
+	newModel := m
+	newModel.ClearTextInput()
+	newModel.SetViewMode(ViewBackups)
+	name, err := storage.CreateBackup(m.storage.FileSystem(), storage.BackupManual, label)
+	switch {
+	case name == "" && err == nil:
+		return newModel, newModel.notifyWarning(i18n.T("backup.nothing_to_backup"))
+	case name == "":
+		return newModel, newModel.notifyError(i18n.T("backup.create_failed", err))
+	}
+	cmd := newModel.notifyInfo(i18n.T("backup.created", name))
+	if err != nil {
+		// The backup exists, only its operation and label are missing
+		cmd = newModel.notifyWarning(i18n.T("backup.created_without_label", name, err))
+	}
+	if err := newModel.loadBackups(name); err != nil {
+		return newModel, tea.Batch(cmd, newModel.notifyError(i18n.T("backup.list_failed", err)))
+	}
+	return newModel, cmd
+}
+
+// HandleConfirmBackupDelete handles the backup delete confirmation
+func (m Model) HandleConfirmBackupDelete(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case isForceQuit(msg):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Confirm):
+		return m.answerBackupDelete(true)
+	case key.Matches(msg, m.keys.Deny):
+		return m.answerBackupDelete(false)
+	}
+	return m, nil
+}
+
+// answerBackupDelete deletes the chosen backup if confirmed and returns to the browser
+func (m Model) answerBackupDelete(confirmed bool) (Model, tea.Cmd) {
+	newModel := m
+	newModel.SetViewMode(ViewBackups)
+	newModel.backupToDelete = ""
+	if !confirmed {
 		return newModel, nil
 	}
-	return newModel, nil
+	if err := storage.DeleteBackup(m.storage.FileSystem(), m.backupToDelete); err != nil {
+		return newModel, newModel.notifyError(i18n.T("backup.delete_failed", err))
+	}
+	// Keep the cursor where the deleted backup was
+	index := m.backupList.Index()
+	if err := newModel.loadBackups(""); err != nil {
+		return newModel, newModel.notifyError(i18n.T("backup.list_failed", err))
+	}
+	newModel.backupList.Select(min(index, max(len(newModel.backupList.VisibleItems())-1, 0)))
+	return newModel, newModel.notifyInfo(i18n.T("backup.deleted", m.backupToDelete))
 }
@@ -0,0 +1,414 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bulkAction is an operation applied to all selected tickets
+type bulkAction int
+
+const (
+	bulkNone bulkAction = iota
+	bulkDelete
+	bulkArchive
+	bulkUnarchive
+	bulkTag
+	bulkStatus
+	bulkExport
+	bulkOpen
+)
+
+// describe returns the confirmation text for the action
+func (a bulkAction) describe(count int, arg string) string {
+	switch a {
+	case bulkDelete:
+		return i18n.T("bulk.describe.delete", count)
+	case bulkArchive:
+		return i18n.T("bulk.describe.archive", count)
+	case bulkUnarchive:
+		return i18n.T("bulk.describe.unarchive", count)
+	case bulkTag:
+		return i18n.T("bulk.describe.tag", arg, count)
+	case bulkStatus:
+		if arg == "" {
+			return i18n.T("bulk.describe.clear_status", count)
+		}
+		return i18n.T("bulk.describe.status", arg, count)
+	case bulkExport:
+		return i18n.T("bulk.describe.export", count, arg)
+	case bulkOpen:
+		return i18n.T("bulk.describe.open", count)
+	}
+	return ""
+}
+
+// selectedIDs returns the IDs of selected tickets that still exist, in storage order
+func (m Model) selectedIDs() []int {
+	var ids []int
+	for _, ticket := range m.storage.Tickets {
+		if m.selection[ticket.ID] {
+			ids = append(ids, ticket.ID)
+		}
+	}
+	return ids
+}
+
+// setSelection replaces the selection and redraws the markers
+func (m *Model) setSelection(selection map[int]bool) {
+	if len(selection) == 0 {
+		selection = nil
+	}
+	m.selection = selection
+	m.applyDelegate()
+}
+
+// copySelection returns a copy of the selection that can be modified
+func (m Model) copySelection() map[int]bool {
+	selection := make(map[int]bool, len(m.selection)+1)
+	for id := range m.selection {
+		selection[id] = true
+	}
+	return selection
+}
+
+// visibleTicketIDs returns the IDs of tickets shown in the list, in list order
+func (m Model) visibleTicketIDs() []int {
+	var ids []int
+	for _, item := range m.list.Items() {
+		if ticket, ok := item.(storage.Ticket); ok {
+			ids = append(ids, ticket.ID)
+		}
+	}
+	return ids
+}
+
+// matchingTicketIDs returns the IDs of visible tickets that match the search,
+// leaving out pinned tickets that are only listed because they are pinned
+func (m Model) matchingTicketIDs() []int {
+	var ids []int
+	for _, id := range m.visibleTicketIDs() {
+		if m.listResult[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// handleToggleSelect selects or deselects the ticket under the cursor; on a group header it folds the group
+func (m Model) handleToggleSelect() (Model, tea.Cmd) {
+	switch item := m.list.SelectedItem().(type) {
+	case groupHeader:
+		return m.toggleGroup(item)
+	case storage.Ticket:
+		newModel := m
+		selection := m.copySelection()
+		if selection[item.ID] {
+			delete(selection, item.ID)
+		} else {
+			selection[item.ID] = true
+		}
+		newModel.setSelection(selection)
+		newModel.list.CursorDown()
+		return newModel, nil
+	}
+	return m, nil
+}
+
+// handleRangeSelect marks the start of a range on the first press and
+// selects every visible ticket up to the cursor on the second
+func (m Model) handleRangeSelect() (Model, tea.Cmd) {
+	ticket, ok := m.list.SelectedItem().(storage.Ticket)
+	if !ok {
+		return m, nil
+	}
+	newModel := m
+	selection := m.copySelection()
+	if m.rangeAnchor == 0 {
+		newModel.rangeAnchor = ticket.ID
+		selection[ticket.ID] = true
+		newModel.setSelection(selection)
+		return newModel, newModel.notifyInfo(i18n.T("bulk.range_started", newModel.keys.SelectRange.Help().Key))
+	}
+
+	ids := m.visibleTicketIDs()
+	from, to := -1, -1
+	for i, id := range ids {
+		if id == m.rangeAnchor {
+			from = i
+		}
+		if id == ticket.ID {
+			to = i
+		}
+	}
+	newModel.rangeAnchor = 0
+	if from < 0 {
+		// The anchor was filtered out meanwhile, start over from the cursor
+		return newModel.handleRangeSelect()
+	}
+	if from > to {
+		from, to = to, from
+	}
+	for _, id := range ids[from : to+1] {
+		selection[id] = true
+	}
+	newModel.setSelection(selection)
+	return newModel, nil
+}
+
+// handleSelectAll selects every visible ticket matching the search, or clears
+// the selection if they all are selected
+func (m Model) handleSelectAll() (Model, tea.Cmd) {
+	newModel := m
+	ids := m.matchingTicketIDs()
+	allSelected := len(ids) > 0
+	for _, id := range ids {
+		if !m.selection[id] {
+			allSelected = false
+			break
+		}
+	}
+	selection := m.copySelection()
+	for _, id := range ids {
+		if allSelected {
+			delete(selection, id)
+		} else {
+			selection[id] = true
+		}
+	}
+	newModel.rangeAnchor = 0
+	newModel.setSelection(selection)
+	return newModel, nil
+}
+
+// handleClearSelection drops the selection and a pending range start
+func (m Model) handleClearSelection() (Model, tea.Cmd) {
+	newModel := m
+	newModel.rangeAnchor = 0
+	newModel.setSelection(nil)
+	return newModel, nil
+}
+
+// handleBulkMenu opens the bulk action menu for the selected tickets
+func (m Model) handleBulkMenu() (Model, tea.Cmd) {
+	newModel := m
+	if len(m.selectedIDs()) == 0 {
+		return newModel, newModel.notifyWarning(i18n.T("bulk.nothing_selected", newModel.keys.Select.Help().Key, newModel.keys.SelectRange.Help().Key, newModel.keys.SelectAll.Help().Key))
+	}
+	newModel.bulkAction = bulkNone
+	newModel.bulkArg = ""
+	newModel.SetViewMode(ViewBulkActions)
+	return newModel, nil
+}
+
+// HandleBulkActions handles the bulk action menu
+func (m Model) HandleBulkActions(msg tea.KeyMsg) (Model, tea.Cmd) {
+	newModel := m
+
+	if isForceQuit(msg) {
+		return newModel, tea.Quit
+	}
+	if key.Matches(msg, m.keys.Back) {
+		newModel.SetViewMode(ViewList)
+		return newModel, nil
+	}
+	if key.Matches(msg, m.keys.Help) {
+		return newModel.showHelp()
+	}
+	switch msg.String() {
+	case "d":
+		return newModel.confirmBulk(bulkDelete, "")
+	case "a":
+		action := bulkArchive
+		if newModel.allSelectedArchived() {
+			action = bulkUnarchive
+		}
+		return newModel.confirmBulk(action, "")
+	case "o":
+		return newModel.confirmBulk(bulkOpen, "")
+	case "t":
+		return newModel.askBulkArg(bulkTag, i18n.T("bulk.placeholder.tag"))
+	case "s":
+		return newModel.askBulkArg(bulkStatus, i18n.T("bulk.placeholder.status"))
+	case "e":
+		return newModel.askBulkArg(bulkExport, i18n.T("bulk.placeholder.export"))
+	case "c":
+		return newModel.copySelectedURLs()
+	}
+	return newModel, nil
+}
+
+func (m Model) allSelectedArchived() bool {
+	tickets := m.storage.TicketsByID(m.selectedIDs())
+	for _, ticket := range tickets {
+		if !ticket.Archived {
+			return false
+		}
+	}
+	return len(tickets) > 0
+}
+
+func (m Model) askBulkArg(action bulkAction, placeholder string) (Model, tea.Cmd) {
+	newModel := m
+	newModel.bulkAction = action
+	newModel.textInput.SetValue("")
+	newModel.textInput.Placeholder = placeholder
+	newModel.textInput.Focus()
+	newModel.SetViewMode(ViewBulkInput)
+	return newModel, nil
+}
+
+func (m Model) confirmBulk(action bulkAction, arg string) (Model, tea.Cmd) {
+	newModel := m
+	newModel.bulkAction = action
+	newModel.bulkArg = arg
+	newModel.SetViewMode(ViewConfirmBulk)
+	return newModel, nil
+}
+
+// HandleBulkInput handles the tag, status or export path input of a bulk action
+func (m Model) HandleBulkInput(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	newModel := m
+
+	switch msg.String() {
+	case "ctrl+c":
+		return newModel, tea.Quit
+	case "esc":
+		newModel.ClearTextInput()
+		newModel.SetViewMode(ViewBulkActions)
+		return newModel, nil
+	case "enter":
+		value := strings.TrimSpace(newModel.textInput.Value())
+		if value == "" && newModel.bulkAction != bulkStatus {
+			return newModel, nil
+		}
+		newModel.ClearTextInput()
+		return newModel.confirmBulk(newModel.bulkAction, value)
+	}
+
+	newModel.textInput, cmd = newModel.textInput.Update(msg)
+	return newModel, cmd
+}
+
+// HandleConfirmBulk handles the confirmation of a bulk action
+func (m Model) HandleConfirmBulk(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case isForceQuit(msg):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Deny):
+		return m.answerBulk(false)
+	case key.Matches(msg, m.keys.Confirm):
+		return m.answerBulk(true)
+	}
+	return m, nil
+}
+
+// answerBulk runs the bulk action if confirmed and returns to the list
+func (m Model) answerBulk(confirmed bool) (Model, tea.Cmd) {
+	if confirmed {
+		return m.executeBulk()
+	}
+	newModel := m
+	newModel.SetViewMode(ViewList)
+	newModel.bulkAction = bulkNone
+	return newModel, nil
+}
+
+// executeBulk applies the confirmed action. Actions that change tickets
+// create a single backup and are saved once.
+func (m Model) executeBulk() (Model, tea.Cmd) {
+	newModel := m
+	newModel.SetViewMode(ViewList)
+	ids := m.selectedIDs()
+	action, arg := m.bulkAction, m.bulkArg
+	newModel.bulkAction = bulkNone
+
+	var count int
+	var err error
+	switch action {
+	case bulkDelete:
+		count, err = newModel.storage.DeleteTickets(ids)
+	case bulkArchive:
+		count, err = newModel.storage.ArchiveTickets(ids, true)
+	case bulkUnarchive:
+		count, err = newModel.storage.ArchiveTickets(ids, false)
+	case bulkTag:
+		count, err = newModel.storage.TagTickets(ids, arg)
+	case bulkStatus:
+		count, err = newModel.storage.SetStatus(ids, arg)
+	case bulkExport:
+		count, err = newModel.storage.ExportTickets(arg, ids)
+		if err != nil {
+			return newModel, newModel.notifyError(i18n.T("bulk.export_failed", err))
+		}
+		return newModel, newModel.notifyInfo(i18n.T("bulk.exported", count, arg))
+	case bulkOpen:
+		return newModel.openSelected(ids)
+	default:
+		return newModel, nil
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		newModel.setSelection(nil)
+		newModel.reapplyFilter()
+		return newModel, newModel.notifyWarning(i18n.T("bulk.already_deleted"))
+	case err != nil:
+		return newModel, newModel.notifyError(i18n.T("bulk.failed", err))
+	}
+
+	if action == bulkDelete || action == bulkArchive || action == bulkUnarchive {
+		newModel.rangeAnchor = 0
+		newModel.setSelection(nil)
+	}
+	cmd := newModel.saveStorage()
+	newModel.reapplyFilter()
+	return newModel, tea.Batch(cmd, newModel.notifyInfo(i18n.T("bulk.done", strings.ToLower(action.describe(count, arg)))))
+}
+
+// openSelected opens every selected ticket in the browser
+func (m Model) openSelected(ids []int) (Model, tea.Cmd) {
+	newModel := m
+	var failed []string
+	opened := 0
+	for _, ticket := range newModel.storage.TicketsByID(ids) {
+		if err := openBrowser(ticket.URL)(); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", ticket.ExtractTicketNumber(), err))
+			continue
+		}
+		newModel.storage.MarkOpened(ticket.ID)
+		opened++
+	}
+	var cmds []tea.Cmd
+	if opened > 0 {
+		cmds = append(cmds, newModel.saveStorage(), newModel.notifyInfo(i18n.T("bulk.opened", opened)))
+	}
+	if len(failed) > 0 {
+		cmds = append(cmds, newModel.notifyError(i18n.T("bulk.open_failed", strings.Join(failed, "; "))))
+	}
+	return newModel, tea.Batch(cmds...)
+}
+
+// copySelectedURLs copies the links of the selected tickets, one per line
+func (m Model) copySelectedURLs() (Model, tea.Cmd) {
+	newModel := m
+	newModel.SetViewMode(ViewList)
+	tickets := m.storage.TicketsByID(m.selectedIDs())
+	urls := make([]string, len(tickets))
+	for i, ticket := range tickets {
+		urls[i] = ticket.URL
+	}
+	if err := clipboard.WriteAll(strings.Join(urls, "\n")); err != nil {
+		return newModel, newModel.notifyError(i18n.T("bulk.copy_failed", err))
+	}
+	return newModel, newModel.notifyInfo(i18n.T("bulk.copied", len(urls)))
+}
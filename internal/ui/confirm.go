@@ -1,60 +1,83 @@
 package ui
 
 import (
+	"errors"
+
+	"gotickets/internal/i18n"
 	"gotickets/internal/storage"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // HandleConfirmDelete handles delete confirmation
 func (m Model) HandleConfirmDelete(msg tea.KeyMsg) (Model, tea.Cmd) {
-	newModel := m
+	switch {
+	case isForceQuit(msg):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Deny):
+		return m.answerDelete(false)
+	case key.Matches(msg, m.keys.Confirm):
+		return m.answerDelete(true)
+	}
+	return m, nil
+}
 
-	switch msg.String() {
-	case "ctrl+c":
-		return newModel, tea.Quit
-	case "esc", "n":
-		newModel.SetViewMode(ViewList)
-		newModel.ticketToDelete = -1
-	case "y", "enter":
-		if newModel.ticketToDelete != -1 {
-			if newModel.storage.DeleteTicket(newModel.ticketToDelete) {
-				newModel.storage.Save()
-				newModel.RefreshList()
-			}
-			newModel.SetViewMode(ViewList)
-			newModel.ticketToDelete = -1
+// answerDelete deletes the ticket if confirmed and returns to the list
+func (m Model) answerDelete(confirmed bool) (Model, tea.Cmd) {
+	newModel := m
+	var cmd tea.Cmd
+	if confirmed && newModel.ticketToDelete != -1 {
+		err := newModel.storage.DeleteTicket(newModel.ticketToDelete)
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			cmd = newModel.notifyWarning(i18n.T("ticket.already_deleted"))
+		case err != nil:
+			cmd = newModel.notifyError(i18n.T("ticket.delete_failed", err))
+		default:
+			cmd = newModel.saveStorage()
+			// Keep the search and the cursor where the deleted ticket was
+			index := newModel.list.Index()
+			newModel.reapplyFilter()
+			newModel.list.Select(min(index, max(len(newModel.list.Items())-1, 0)))
 		}
 	}
-	return newModel, nil
+	newModel.SetViewMode(ViewList)
+	newModel.ticketToDelete = -1
+	return newModel, cmd
 }
 
 // HandleConfirmRestore handles backup restore confirmation
 func (m Model) HandleConfirmRestore(msg tea.KeyMsg) (Model, tea.Cmd) {
-	newModel := m
+	switch {
+	case isForceQuit(msg):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Confirm):
+		return m.answerRestore(true)
+	case key.Matches(msg, m.keys.Deny):
+		return m.answerRestore(false)
+	}
+	return m, nil
+}
 
-	switch msg.String() {
-	case "ctrl+c", "q":
-		newModel.SetViewMode(ViewList)
-		newModel.selectedBackupIndex = -1
-		return newModel, nil
-	case "y", "Y":
-		// Confirm restore
-		err := storage.RestoreFromBackupUsing(&storage.RealFileSystem{}, newModel.backupToRestore)
-		if err != nil {
-			return newModel, nil
-		}
-		// Reload tickets after restore
-		storage, _ := storage.LoadTicketsWithFS(&storage.RealFileSystem{})
-		newModel.SetStorage(storage)
-		newModel.RefreshList()
-		newModel.SetViewMode(ViewList)
-		newModel.selectedBackupIndex = -1
-		return newModel, nil
-	case "n", "N", "esc":
-		newModel.SetViewMode(ViewList)
-		newModel.selectedBackupIndex = -1
+// answerRestore restores the chosen backup if confirmed
+func (m Model) answerRestore(confirmed bool) (Model, tea.Cmd) {
+	newModel := m
+	if !confirmed {
+		newModel.SetViewMode(ViewBackups)
 		return newModel, nil
 	}
-	return newModel, nil
+	fs := newModel.storage.FileSystem()
+	if err := storage.RestoreFromBackupUsing(fs, newModel.backupToRestore); err != nil {
+		newModel.SetViewMode(ViewBackups)
+		return newModel, newModel.notifyError(i18n.T("backup.restore_failed", err))
+	}
+	// Reload tickets after restore
+	ticketStorage, _ := storage.LoadTicketsWithFS(fs)
+	ticketStorage.SetURLPolicy(newModel.config.URLPolicy())
+	newModel.SetStorage(ticketStorage)
+	newModel.dirty = false
+	newModel.RefreshList()
+	newModel.SetViewMode(ViewList)
+	return newModel, newModel.notifyInfo(i18n.T("backup.restored", newModel.backupToRestore))
 }
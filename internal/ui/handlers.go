@@ -1,36 +1,81 @@
 package ui
 
 import (
+	"errors"
 	"strings"
 
+	"gotickets/internal/i18n"
 	"gotickets/internal/storage"
 
 	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // HandleListView handles input for the main list view
 func (m Model) HandleListView(msg tea.KeyMsg) (Model, tea.Cmd) {
-	switch msg.String() {
-	case "ctrl+c", "q":
+	keys := m.keys
+	switch {
+	case isForceQuit(msg):
 		return m, tea.Quit
-	case "enter":
+	case key.Matches(msg, keys.Quit):
+		return m.handleQuit()
+	case key.Matches(msg, keys.Save):
+		return m.handleRetrySave()
+	case key.Matches(msg, keys.Copy):
 		return m.handleEnterInList()
-	case "a":
+	case key.Matches(msg, keys.Add):
 		return m.handleAddTicket()
-	case "/":
+	case key.Matches(msg, keys.Search):
 		return m.handleSearch()
-	case "r":
+	case key.Matches(msg, keys.Refresh):
 		m.RefreshList()
 		return m, nil
-	case "d":
+	case key.Matches(msg, keys.Delete):
 		return m.handleDeleteTicket()
-	case "o":
+	case key.Matches(msg, keys.Open):
 		return m.handleOpenTicket()
-	case "i":
+	case key.Matches(msg, keys.Import):
 		return m.handleImport()
-	case "b":
+	case key.Matches(msg, keys.Paste):
+		return m.handlePasteBulk()
+	case key.Matches(msg, keys.Backups):
 		return m.handleBackups()
+	case key.Matches(msg, keys.Filters):
+		return m.handleSavedSearches()
+	case key.Matches(msg, keys.SaveFilter):
+		return m.handleSaveSearch()
+	case key.Matches(msg, keys.Sort):
+		return m.handleCycleSort()
+	case key.Matches(msg, keys.Group):
+		return m.handleCycleGroup()
+	case key.Matches(msg, keys.Select):
+		return m.handleToggleSelect()
+	case key.Matches(msg, keys.SelectRange):
+		return m.handleRangeSelect()
+	case key.Matches(msg, keys.SelectAll):
+		return m.handleSelectAll()
+	case key.Matches(msg, keys.Bulk):
+		return m.handleBulkMenu()
+	case key.Matches(msg, keys.Pin):
+		return m.handleTogglePin()
+	case key.Matches(msg, keys.Recent):
+		return m.handleRecent()
+	case key.Matches(msg, keys.Palette):
+		return m.handlePalette()
+	case key.Matches(msg, keys.Help):
+		return m.showHelp()
+	case key.Matches(msg, keys.Details):
+		if ticket, ok := m.list.SelectedItem().(storage.Ticket); ok {
+			return m.showDetail(ticket.ID)
+		}
+		return m, nil
+	case key.Matches(msg, keys.ClearSelection) && (m.selection != nil || m.rangeAnchor != 0):
+		return m.handleClearSelection()
+	}
+
+	if index, ok := quickFilterIndex(msg.String()); ok {
+		return m.applySavedSearch(index)
 	}
 
 	// Let the list handle other keys (navigation, filtering, etc.)
@@ -40,15 +85,51 @@ func (m Model) HandleListView(msg tea.KeyMsg) (Model, tea.Cmd) {
 }
 
 func (m Model) handleEnterInList() (Model, tea.Cmd) {
-	if selectedItem := m.list.SelectedItem(); selectedItem != nil {
-		if ticket, ok := selectedItem.(storage.Ticket); ok && ticket.URL != "" {
-			// Copy URL to clipboard
-			clipboard.WriteAll(ticket.URL)
-		}
+	if header, ok := m.list.SelectedItem().(groupHeader); ok {
+		return m.toggleGroup(header)
+	}
+	if ticket, ok := m.list.SelectedItem().(storage.Ticket); ok {
+		return m.copyTicket(ticket)
 	}
 	return m, nil
 }
 
+// copyTicket copies the ticket link to the clipboard and records the use
+func (m Model) copyTicket(ticket storage.Ticket) (Model, tea.Cmd) {
+	newModel := m
+	if ticket.URL == "" {
+		return newModel, nil
+	}
+	if err := clipboard.WriteAll(ticket.URL); err != nil {
+		return newModel, newModel.notifyError(i18n.T("ticket.copy_failed", err))
+	}
+	return newModel, tea.Batch(newModel.recordUse(ticket.ID, newModel.storage.MarkCopied), newModel.notifyInfo(i18n.T("ticket.copied")))
+}
+
+// openTicket opens the ticket in the browser and records the use
+func (m Model) openTicket(ticket storage.Ticket) (Model, tea.Cmd) {
+	newModel := m
+	if ticket.URL == "" {
+		return newModel, nil
+	}
+	if err := openBrowser(ticket.URL)(); err != nil {
+		return newModel, newModel.notifyError(i18n.T("ticket.open_failed", err))
+	}
+	return newModel, newModel.recordUse(ticket.ID, newModel.storage.MarkOpened)
+}
+
+// recordUse marks a ticket as used, saves it and re-sorts the list if the order depends on use
+func (m *Model) recordUse(id int, mark func(int) error) tea.Cmd {
+	if err := mark(id); err != nil {
+		return nil
+	}
+	cmd := m.saveStorage()
+	if m.sortMode == storage.SortLastOpened || m.sortMode == storage.SortRecent {
+		m.reapplyFilter()
+	}
+	return cmd
+}
+
 func (m Model) handleAddTicket() (Model, tea.Cmd) {
 	newModel := m
 	newModel.SetViewMode(ViewAddURL)
@@ -75,10 +156,8 @@ func (m Model) handleDeleteTicket() (Model, tea.Cmd) {
 }
 
 func (m Model) handleOpenTicket() (Model, tea.Cmd) {
-	if selectedItem := m.list.SelectedItem(); selectedItem != nil {
-		if ticket, ok := selectedItem.(storage.Ticket); ok && ticket.URL != "" {
-			go openBrowser(ticket.URL)()
-		}
+	if ticket, ok := m.list.SelectedItem().(storage.Ticket); ok {
+		return m.openTicket(ticket)
 	}
 	return m, nil
 }
@@ -90,23 +169,6 @@ func (m Model) handleImport() (Model, tea.Cmd) {
 	return newModel, nil
 }
 
-func (m Model) handleBackups() (Model, tea.Cmd) {
-	backups, err := storage.ListBackupsUsing(&storage.RealFileSystem{})
-	if err != nil {
-		return m, nil
-	}
-
-	newModel := m
-	newModel.backups = backups
-	if len(backups) > 0 {
-		newModel.selectedBackupIndex = 0
-	} else {
-		newModel.selectedBackupIndex = -1
-	}
-	newModel.SetViewMode(ViewBackups)
-	return newModel, nil
-}
-
 // HandleAddURL handles input for URL entry
 func (m Model) HandleAddURL(msg tea.KeyMsg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -141,15 +203,17 @@ func (m Model) handleURLSubmit() (Model, tea.Cmd) {
 		return m, nil
 	}
 
-	// Check for duplicate URL
-	if m.storage.HasTicketWithURL(value) {
+	resolved, err := m.storage.ResolveNewURL(value)
+	if err != nil {
 		newModel := m
-		newModel.urlError = "Тикет с такой ссылкой уже существует!"
+		newModel.urlError = err.Error()
 		return newModel, nil
 	}
 
 	newModel := m
+	// Keep what was typed so the ticket key survives until the ticket is added
 	newModel.tempURL = value
+	newModel.tempResolved = resolved
 	newModel.SetViewMode(ViewAddTitle)
 	newModel.SetupTextInputForTitle()
 	return newModel, nil
@@ -184,16 +248,24 @@ func (m Model) handleTitleSubmit() (Model, tea.Cmd) {
 	}
 
 	newModel := m
-	newModel.storage.AddTicket(value, newModel.tempURL)
-	newModel.storage.Save()
+	ticket, err := newModel.storage.AddTicket(value, newModel.tempURL)
+	if errors.Is(err, storage.ErrInvalidURL) || errors.Is(err, storage.ErrDuplicateURL) {
+		// The URL became unusable meanwhile, go back to the URL step
+		newModel.SetViewMode(ViewAddURL)
+		newModel.SetupTextInputForURL()
+		newModel.textInput.SetValue(m.tempURL)
+		newModel.urlError = err.Error()
+		return newModel, nil
+	}
+	if err != nil {
+		// Keep the entered title so the user can retry
+		return newModel, newModel.notifyError(i18n.T("ticket.add_failed", err))
+	}
+	cmd := newModel.saveStorage()
 	newModel.RefreshList()
 	newModel.SetViewMode(ViewList)
 	newModel.ClearTextInput()
 	newModel.tempURL = ""
-
-	// Move to the last item (newly added ticket)
-	if len(newModel.storage.Tickets) > 0 {
-		newModel.list.Select(len(newModel.storage.Tickets) - 1)
-	}
-	return newModel, nil
+	newModel.selectTicket(ticket.ID)
+	return newModel, cmd
 }
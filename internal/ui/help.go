@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"gotickets/internal/i18n"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// helpEntry is one line of the help overlay
+type helpEntry struct {
+	keys string
+	desc string
+}
+
+// helpSection groups the entries of the help overlay under a title
+type helpSection struct {
+	title   string
+	entries []helpEntry
+}
+
+// bindingHelp describes a binding with its own help text
+func bindingHelp(b key.Binding) helpEntry {
+	return helpEntry{keys: b.Help().Key, desc: b.Help().Desc}
+}
+
+// bindingHelpAs describes a binding with a help text specific to a view
+func bindingHelpAs(b key.Binding, desc string) helpEntry {
+	return helpEntry{keys: b.Help().Key, desc: desc}
+}
+
+// showHelp opens the help overlay for the current view
+func (m Model) showHelp() (Model, tea.Cmd) {
+	newModel := m
+	newModel.helpReturn = m.viewMode
+	newModel.helpOffset = 0
+	newModel.SetViewMode(ViewHelp)
+	return newModel, nil
+}
+
+// helpSections lists every binding of a view
+func (m Model) helpSections(mode ViewMode) []helpSection {
+	k := m.keys
+	navigate := helpEntry{keys: k.Up.Help().Key + "/" + k.Down.Help().Key, desc: i18n.T("help.navigate")}
+	general := helpSection{title: i18n.T("help.section.general"), entries: []helpEntry{
+		bindingHelpAs(k.Help, i18n.T("help.close_help")),
+		{keys: forceQuitKey, desc: i18n.T("help.force_quit")},
+	}}
+
+	switch mode {
+	case ViewSavedSearches:
+		return []helpSection{{title: i18n.T("view.saved.header"), entries: []helpEntry{
+			navigate,
+			bindingHelpAs(k.Choose, i18n.T("help.apply")),
+			{keys: "1-9", desc: i18n.T("help.quick_filter")},
+			{keys: "d", desc: i18n.T("help.delete")},
+			bindingHelpAs(k.Back, i18n.T("help.back")),
+		}}, general}
+	case ViewRecent:
+		return []helpSection{{title: i18n.T("view.recent.header"), entries: []helpEntry{
+			navigate,
+			bindingHelpAs(k.Choose, i18n.T("help.show_in_list")),
+			{keys: "o", desc: i18n.T("help.open")},
+			{keys: "c", desc: i18n.T("help.copy")},
+			{keys: "v", desc: i18n.T("help.details")},
+			{keys: k.Back.Help().Key + "/" + k.Recent.Help().Key, desc: i18n.T("help.back")},
+		}}, general}
+	case ViewDetail:
+		return []helpSection{{title: i18n.T("help.section.detail"), entries: []helpEntry{
+			{keys: "o", desc: i18n.T("help.open")},
+			{keys: "enter/c", desc: i18n.T("help.copy")},
+			{keys: k.Back.Help().Key + "/" + k.Details.Help().Key, desc: i18n.T("help.back")},
+		}}, general}
+	case ViewBackups:
+		return []helpSection{{title: i18n.T("view.backups.header"), entries: []helpEntry{
+			navigate,
+			{keys: k.PageUp.Help().Key + "/" + k.PageDown.Help().Key, desc: i18n.T("help.page")},
+			bindingHelpAs(k.Choose, i18n.T("help.restore")),
+			{keys: "d", desc: i18n.T("help.delete")},
+			{keys: "e", desc: i18n.T("help.export")},
+			{keys: "n", desc: i18n.T("help.new_backup")},
+			bindingHelpAs(k.Search, i18n.T("help.filter")),
+			{keys: k.Back.Help().Key, desc: i18n.T("help.back_or_clear")},
+		}}, general}
+	case ViewBulkActions:
+		return []helpSection{{title: i18n.T("help.section.bulk"), entries: []helpEntry{
+			{keys: "d", desc: i18n.T("help.delete")},
+			{keys: "a", desc: i18n.T("view.bulk.archive") + " / " + i18n.T("view.bulk.unarchive")},
+			{keys: "t", desc: i18n.T("view.bulk.tag")},
+			{keys: "s", desc: i18n.T("view.bulk.status")},
+			{keys: "e", desc: i18n.T("view.bulk.export")},
+			{keys: "c", desc: i18n.T("view.bulk.copy")},
+			{keys: "o", desc: i18n.T("view.bulk.open")},
+			bindingHelpAs(k.Back, i18n.T("help.back")),
+		}}, general}
+	}
+
+	return []helpSection{
+		{title: i18n.T("help.section.navigation"), entries: []helpEntry{
+			bindingHelp(k.Up), bindingHelp(k.Down), bindingHelp(k.PageUp),
+			bindingHelp(k.PageDown), bindingHelp(k.Top), bindingHelp(k.Bottom),
+		}},
+		{title: i18n.T("help.section.tickets"), entries: []helpEntry{
+			bindingHelp(k.Copy), bindingHelp(k.Open), bindingHelp(k.Add), bindingHelp(k.Delete),
+			bindingHelp(k.Pin), bindingHelp(k.Details), bindingHelp(k.Recent), bindingHelp(k.Import),
+			bindingHelp(k.Paste), bindingHelp(k.Backups), bindingHelp(k.Save), bindingHelp(k.Refresh),
+		}},
+		{title: i18n.T("help.section.search"), entries: []helpEntry{
+			bindingHelp(k.Search), bindingHelp(k.Filters), bindingHelp(k.SaveFilter),
+			{keys: "1-9", desc: i18n.T("help.quick_filter")},
+		}},
+		{title: i18n.T("help.section.selection"), entries: []helpEntry{
+			bindingHelp(k.Select), bindingHelp(k.SelectRange), bindingHelp(k.SelectAll),
+			bindingHelp(k.ClearSelection), bindingHelp(k.Bulk),
+		}},
+		{title: i18n.T("help.section.view"), entries: []helpEntry{
+			bindingHelp(k.Sort), bindingHelp(k.Group), bindingHelp(k.Palette), bindingHelp(k.Quit),
+		}},
+		general,
+	}
+}
+
+// helpLines renders the sections of the help overlay, one line per entry
+func (m Model) helpLines() []string {
+	sections := m.helpSections(m.helpReturn)
+	width := 0
+	for _, section := range sections {
+		for _, entry := range section.entries {
+			width = max(width, lipgloss.Width(entry.keys))
+		}
+	}
+	var lines []string
+	for i, section := range sections {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.styles.GroupHeader.Render(section.title))
+		for _, entry := range section.entries {
+			padding := strings.Repeat(" ", width-lipgloss.Width(entry.keys))
+			lines = append(lines, fmt.Sprintf("  %s%s  %s", m.styles.Key.Render(entry.keys), padding, m.styles.Action.Render(entry.desc)))
+		}
+	}
+	return lines
+}
+
+// helpHeight is the number of help lines that fit on the screen
+func (m Model) helpHeight() int {
+	return max(m.list.Height(), 5)
+}
+
+// HandleHelp handles the help overlay: scrolling and closing
+func (m Model) HandleHelp(msg tea.KeyMsg) (Model, tea.Cmd) {
+	newModel := m
+	maxOffset := max(len(m.helpLines())-m.helpHeight(), 0)
+
+	switch {
+	case isForceQuit(msg):
+		return newModel, tea.Quit
+	case key.Matches(msg, m.keys.Back, m.keys.Help):
+		newModel.SetViewMode(m.helpReturn)
+	case key.Matches(msg, m.keys.Up):
+		newModel.helpOffset = max(newModel.helpOffset-1, 0)
+	case key.Matches(msg, m.keys.Down):
+		newModel.helpOffset = min(newModel.helpOffset+1, maxOffset)
+	case key.Matches(msg, m.keys.PageUp):
+		newModel.helpOffset = max(newModel.helpOffset-m.helpHeight(), 0)
+	case key.Matches(msg, m.keys.PageDown):
+		newModel.helpOffset = min(newModel.helpOffset+m.helpHeight(), maxOffset)
+	case key.Matches(msg, m.keys.Top):
+		newModel.helpOffset = 0
+	case key.Matches(msg, m.keys.Bottom):
+		newModel.helpOffset = maxOffset
+	}
+	return newModel, nil
+}
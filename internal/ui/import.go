@@ -1,10 +1,14 @@
 package ui
 
 import (
+	"errors"
 	"strings"
 
+	"gotickets/internal/i18n"
 	"gotickets/internal/storage"
 
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -19,12 +23,29 @@ func (m Model) HandleImport(msg tea.KeyMsg) (Model, tea.Cmd) {
 	case "esc":
 		newModel.SetViewMode(ViewList)
 		newModel.ClearTextInput()
+		newModel.formatInput.Blur()
 		return newModel, nil
 	case "enter":
 		return m.handleImportSubmit()
+	case "tab", "shift+tab":
+		// Switch focus between the file path and the line format
+		newModel.formatFocused = !newModel.formatFocused
+		if newModel.formatFocused {
+			newModel.textInput.Blur()
+			newModel.formatInput.Focus()
+		} else {
+			newModel.formatInput.Blur()
+			newModel.textInput.Focus()
+		}
+		return newModel, nil
 	}
 
-	// Let textinput handle the input
+	// Let the focused input handle the input
+	if newModel.formatFocused {
+		newModel.importFormatError = ""
+		newModel.formatInput, cmd = newModel.formatInput.Update(msg)
+		return newModel, cmd
+	}
 	newModel.textInput, cmd = newModel.textInput.Update(msg)
 	return newModel, cmd
 }
@@ -35,8 +56,17 @@ func (m Model) handleImportSubmit() (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	format, err := storage.ParseImportFormat(m.formatInput.Value())
+	if err != nil {
+		newModel := m
+		newModel.importFormatError = err.Error()
+		return newModel, nil
+	}
+
 	newModel := m
-	result, err := newModel.storage.ImportFromFile(filePath)
+	newModel.ClearTextInput()
+	newModel.formatInput.Blur()
+	preview, err := newModel.storage.PreviewImportFile(filePath, format)
 	if err != nil {
 		// Show error as result with 0 added tickets
 		newModel.importResult = &storage.ImportResult{
@@ -45,21 +75,125 @@ func (m Model) handleImportSubmit() (Model, tea.Cmd) {
 			Errors:     1,
 			ErrorLines: []string{err.Error()},
 		}
-	} else {
-		newModel.importResult = result
-		// Save changes if tickets were added
-		if result.Added > 0 {
-			newModel.storage.Save()
-			newModel.RefreshList()
-			// Navigate to last item to show newly imported tickets
-			if len(newModel.storage.Tickets) > 0 {
-				newModel.list.Select(len(newModel.storage.Tickets) - 1)
-			}
+		newModel.SetViewMode(ViewImportResult)
+		return newModel, nil
+	}
+
+	newModel.importPreview = preview
+	newModel.previewIndex = 0
+	newModel.previewEditing = false
+	newModel.SetViewMode(ViewImportPreview)
+	return newModel, nil
+}
+
+// handlePasteBulk reads a block of links from the clipboard and opens the import preview
+func (m Model) handlePasteBulk() (Model, tea.Cmd) {
+	newModel := m
+	text, err := clipboard.ReadAll()
+	if err == nil && strings.TrimSpace(text) == "" {
+		err = errors.New(i18n.T("paste.empty"))
+	}
+	var preview *storage.ImportPreview
+	if err == nil {
+		// Reuse the format chosen in the import view; fall back to detection if it is invalid
+		format, formatErr := storage.ParseImportFormat(newModel.formatInput.Value())
+		if formatErr != nil {
+			format = nil
 		}
+		preview, err = newModel.storage.PreviewImport(strings.NewReader(text), format)
+	}
+	if err != nil {
+		return newModel, newModel.notifyError(i18n.T("paste.read_failed", err))
+	}
+
+	newModel.importPreview = preview
+	newModel.previewIndex = 0
+	newModel.previewEditing = false
+	newModel.SetViewMode(ViewImportPreview)
+	return newModel, nil
+}
+
+// HandleImportPreview handles navigation, selection and title editing in the import preview
+func (m Model) HandleImportPreview(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.previewEditing {
+		return m.handlePreviewTitleEdit(msg)
 	}
 
+	newModel := m
+	candidates := newModel.importPreview.Candidates
+
+	switch {
+	case isForceQuit(msg):
+		return newModel, tea.Quit
+	case key.Matches(msg, m.keys.Back):
+		newModel.SetViewMode(ViewList)
+		newModel.importPreview = nil
+		return newModel, nil
+	case key.Matches(msg, m.keys.Up):
+		if newModel.previewIndex > 0 {
+			newModel.previewIndex--
+		}
+	case key.Matches(msg, m.keys.Down):
+		if newModel.previewIndex < len(candidates)-1 {
+			newModel.previewIndex++
+		}
+	case key.Matches(msg, m.keys.Choose):
+		return newModel.applyImportPreview()
+	case msg.String() == " ":
+		newModel.importPreview.Toggle(newModel.previewIndex)
+	case msg.String() == "a":
+		newModel.importPreview.SetAll(newModel.importPreview.Selected() == 0)
+	case msg.String() == "e":
+		if len(candidates) > 0 && candidates[newModel.previewIndex].Status == storage.ImportNew {
+			newModel.previewEditing = true
+			newModel.textInput.SetValue(candidates[newModel.previewIndex].Title)
+			newModel.textInput.Placeholder = i18n.T("input.placeholder.title")
+			newModel.textInput.CursorEnd()
+			newModel.textInput.Focus()
+		}
+	}
+	return newModel, nil
+}
+
+func (m Model) handlePreviewTitleEdit(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	newModel := m
+
+	switch msg.String() {
+	case "ctrl+c":
+		return newModel, tea.Quit
+	case "esc":
+		newModel.previewEditing = false
+		newModel.ClearTextInput()
+		return newModel, nil
+	case "enter":
+		newModel.importPreview.SetTitle(newModel.previewIndex, newModel.textInput.Value())
+		newModel.previewEditing = false
+		newModel.ClearTextInput()
+		return newModel, nil
+	}
+
+	newModel.textInput, cmd = newModel.textInput.Update(msg)
+	return newModel, cmd
+}
+
+func (m Model) applyImportPreview() (Model, tea.Cmd) {
+	newModel := m
+	result, err := newModel.storage.ApplyImport(newModel.importPreview)
+	newModel.importPreview = nil
+	newModel.importResult = result
+	if result.Added > 0 {
+		newModel.reapplyFilter()
+		// Navigate to the last imported ticket if it matches the search
+		if len(newModel.storage.Tickets) > 0 {
+			newModel.selectTicket(newModel.storage.Tickets[len(newModel.storage.Tickets)-1].ID)
+		}
+	}
 	newModel.SetViewMode(ViewImportResult)
-	newModel.ClearTextInput()
+	if err != nil {
+		// The summary only counts lines that could not be read
+		return newModel, newModel.notifyError(i18n.T("import.failed", err))
+	}
 	return newModel, nil
 }
 
@@ -71,7 +205,7 @@ func (m Model) HandleImportResult(msg tea.KeyMsg) (Model, tea.Cmd) {
 	case "enter", " ":
 		newModel.SetViewMode(ViewList)
 		newModel.importResult = nil
-		newModel.RefreshList()
+		newModel.reapplyFilter()
 		return newModel, nil
 	case "esc":
 		newModel.SetViewMode(ViewList)
@@ -1,20 +1,33 @@
 package ui
 
-import "github.com/charmbracelet/bubbles/textinput"
+import (
+	"github.com/charmbracelet/bubbles/textinput"
+
+	"gotickets/internal/i18n"
+)
 
 // createTextInput creates and configures the text input component
 func createTextInput() textinput.Model {
 	ti := textinput.New()
-	ti.Placeholder = "Enter text..."
+	ti.Placeholder = i18n.T("input.placeholder.text")
 	ti.CharLimit = 500
 	ti.Width = 60
 	return ti
 }
 
+// createFormatInput creates the text input for the import line format
+func createFormatInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "auto"
+	ti.CharLimit = 200
+	ti.Width = 60
+	return ti
+}
+
 // SetupTextInputForURL configures text input for URL entry
 func (m *Model) SetupTextInputForURL() {
 	m.textInput.SetValue("")
-	m.textInput.Placeholder = "Enter URL..."
+	m.textInput.Placeholder = i18n.T("input.placeholder.url")
 	m.textInput.Focus()
 	m.tempURL = ""
 	m.urlError = ""
@@ -23,7 +36,7 @@ func (m *Model) SetupTextInputForURL() {
 // SetupTextInputForTitle configures text input for title entry
 func (m *Model) SetupTextInputForTitle() {
 	m.textInput.SetValue("")
-	m.textInput.Placeholder = "Enter ticket title..."
+	m.textInput.Placeholder = i18n.T("input.placeholder.title")
 	m.textInput.Focus()
 	m.urlError = ""
 }
@@ -32,15 +45,19 @@ func (m *Model) SetupTextInputForTitle() {
 func (m *Model) SetupTextInputForSearch() {
 	m.searchMode = true
 	m.textInput.SetValue("")
-	m.textInput.Placeholder = "Search tickets..."
+	m.textInput.Placeholder = i18n.T("input.placeholder.search")
 	m.textInput.Focus()
 }
 
-// SetupTextInputForImport configures text input for import file path
+// SetupTextInputForImport configures text input for import file path.
+// The format input keeps its value so the last used format is reused.
 func (m *Model) SetupTextInputForImport() {
 	m.textInput.SetValue("")
-	m.textInput.Placeholder = "Enter path to .txt file..."
+	m.textInput.Placeholder = i18n.T("input.placeholder.import")
 	m.textInput.Focus()
+	m.formatInput.Blur()
+	m.formatFocused = false
+	m.importFormatError = ""
 }
 
 // ClearTextInput resets text input to default state
@@ -0,0 +1,403 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gotickets/internal/i18n"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// KeyMap holds the configurable key bindings. List bindings are used in the
+// main list; Choose, Back, Confirm and Deny are shared by pickers and dialogs.
+type KeyMap struct {
+	// Navigation, shared by the list and the pickers
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Top      key.Binding
+	Bottom   key.Binding
+
+	// List actions
+	Quit           key.Binding
+	Save           key.Binding
+	Copy           key.Binding
+	Add            key.Binding
+	Search         key.Binding
+	Refresh        key.Binding
+	Delete         key.Binding
+	Open           key.Binding
+	Import         key.Binding
+	Paste          key.Binding
+	Backups        key.Binding
+	Filters        key.Binding
+	SaveFilter     key.Binding
+	Sort           key.Binding
+	Group          key.Binding
+	Select         key.Binding
+	SelectRange    key.Binding
+	SelectAll      key.Binding
+	ClearSelection key.Binding
+	Bulk           key.Binding
+	Pin            key.Binding
+	Details        key.Binding
+	Recent         key.Binding
+	Palette        key.Binding
+	Help           key.Binding
+
+	// Pickers and dialogs
+	Choose  key.Binding
+	Back    key.Binding
+	Confirm key.Binding
+	Deny    key.Binding
+}
+
+// forceQuitKey always quits and cannot be rebound
+const forceQuitKey = "ctrl+c"
+
+// Key contexts: bindings of the same context must not share keys
+const (
+	keyContextList   = "list"
+	keyContextPicker = "picker"
+	keyContextDialog = "dialog"
+)
+
+// keyAction describes a binding as it is named in config.json
+type keyAction struct {
+	name     string
+	help     string // catalog key
+	binding  func(*KeyMap) *key.Binding
+	contexts []string
+}
+
+var keyActions = []keyAction{
+	{"up", "keys.help.up", func(k *KeyMap) *key.Binding { return &k.Up }, []string{keyContextList, keyContextPicker}},
+	{"down", "keys.help.down", func(k *KeyMap) *key.Binding { return &k.Down }, []string{keyContextList, keyContextPicker}},
+	{"page_up", "keys.help.page_up", func(k *KeyMap) *key.Binding { return &k.PageUp }, []string{keyContextList}},
+	{"page_down", "keys.help.page_down", func(k *KeyMap) *key.Binding { return &k.PageDown }, []string{keyContextList}},
+	{"top", "keys.help.top", func(k *KeyMap) *key.Binding { return &k.Top }, []string{keyContextList}},
+	{"bottom", "keys.help.bottom", func(k *KeyMap) *key.Binding { return &k.Bottom }, []string{keyContextList}},
+	{"quit", "keys.help.quit", func(k *KeyMap) *key.Binding { return &k.Quit }, []string{keyContextList}},
+	{"save", "keys.help.save", func(k *KeyMap) *key.Binding { return &k.Save }, []string{keyContextList}},
+	{"copy", "keys.help.copy", func(k *KeyMap) *key.Binding { return &k.Copy }, []string{keyContextList}},
+	{"add", "keys.help.add", func(k *KeyMap) *key.Binding { return &k.Add }, []string{keyContextList}},
+	{"search", "keys.help.search", func(k *KeyMap) *key.Binding { return &k.Search }, []string{keyContextList}},
+	{"refresh", "keys.help.refresh", func(k *KeyMap) *key.Binding { return &k.Refresh }, []string{keyContextList}},
+	{"delete", "keys.help.delete", func(k *KeyMap) *key.Binding { return &k.Delete }, []string{keyContextList}},
+	{"open", "keys.help.open", func(k *KeyMap) *key.Binding { return &k.Open }, []string{keyContextList}},
+	{"import", "keys.help.import", func(k *KeyMap) *key.Binding { return &k.Import }, []string{keyContextList}},
+	{"paste", "keys.help.paste", func(k *KeyMap) *key.Binding { return &k.Paste }, []string{keyContextList}},
+	{"backups", "keys.help.backups", func(k *KeyMap) *key.Binding { return &k.Backups }, []string{keyContextList}},
+	{"filters", "keys.help.filters", func(k *KeyMap) *key.Binding { return &k.Filters }, []string{keyContextList}},
+	{"save_filter", "keys.help.save_filter", func(k *KeyMap) *key.Binding { return &k.SaveFilter }, []string{keyContextList}},
+	{"sort", "keys.help.sort", func(k *KeyMap) *key.Binding { return &k.Sort }, []string{keyContextList}},
+	{"group", "keys.help.group", func(k *KeyMap) *key.Binding { return &k.Group }, []string{keyContextList}},
+	{"select", "keys.help.select", func(k *KeyMap) *key.Binding { return &k.Select }, []string{keyContextList}},
+	{"select_range", "keys.help.select_range", func(k *KeyMap) *key.Binding { return &k.SelectRange }, []string{keyContextList}},
+	{"select_all", "keys.help.select_all", func(k *KeyMap) *key.Binding { return &k.SelectAll }, []string{keyContextList}},
+	{"clear_selection", "keys.help.clear_selection", func(k *KeyMap) *key.Binding { return &k.ClearSelection }, []string{keyContextList}},
+	{"bulk", "keys.help.bulk", func(k *KeyMap) *key.Binding { return &k.Bulk }, []string{keyContextList}},
+	{"pin", "keys.help.pin", func(k *KeyMap) *key.Binding { return &k.Pin }, []string{keyContextList}},
+	{"details", "keys.help.details", func(k *KeyMap) *key.Binding { return &k.Details }, []string{keyContextList}},
+	{"recent", "keys.help.recent", func(k *KeyMap) *key.Binding { return &k.Recent }, []string{keyContextList}},
+	{"palette", "keys.help.palette", func(k *KeyMap) *key.Binding { return &k.Palette }, []string{keyContextList}},
+	{"help", "keys.help.help", func(k *KeyMap) *key.Binding { return &k.Help }, []string{keyContextList, keyContextPicker}},
+	{"choose", "keys.help.choose", func(k *KeyMap) *key.Binding { return &k.Choose }, []string{keyContextPicker}},
+	{"back", "keys.help.back", func(k *KeyMap) *key.Binding { return &k.Back }, []string{keyContextPicker}},
+	{"confirm", "keys.help.confirm", func(k *KeyMap) *key.Binding { return &k.Confirm }, []string{keyContextDialog}},
+	{"deny", "keys.help.deny", func(k *KeyMap) *key.Binding { return &k.Deny }, []string{keyContextDialog}},
+}
+
+// reservedListKeys are handled by the list outside of the keymap
+var reservedListKeys = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}
+
+// reservedPickerKeys are handled outside of the keymap by the views that use
+// the picker keys, by view
+var reservedPickerKeys = []struct {
+	view string
+	keys []string
+}{
+	{"import_preview", []string{" ", "a", "e"}},
+	{"saved_filters", append([]string{"d"}, reservedListKeys...)},
+	{"recent", []string{"o", "c", "v"}},
+	{"details", []string{"o", "c"}},
+	{"backups", []string{"d", "e", "n"}},
+	{"bulk_actions", []string{"d", "a", "o", "t", "s", "e", "c"}},
+}
+
+// defaultKeys are the bindings of the default preset
+var defaultKeys = map[string][]string{
+	"up":              {"up", "k"},
+	"down":            {"down", "j"},
+	"page_up":         {"pgup", "left"},
+	"page_down":       {"pgdown", "right"},
+	"top":             {"home", "g"},
+	"bottom":          {"end", "G"},
+	"quit":            {"q"},
+	"save":            {"ctrl+s"},
+	"copy":            {"enter"},
+	"add":             {"a"},
+	"search":          {"/"},
+	"refresh":         {"r"},
+	"delete":          {"d"},
+	"open":            {"o"},
+	"import":          {"i"},
+	"paste":           {"p"},
+	"backups":         {"b"},
+	"filters":         {"f"},
+	"save_filter":     {"F"},
+	"sort":            {"s"},
+	"group":           {"z"},
+	"select":          {" "},
+	"select_range":    {"V"},
+	"select_all":      {"A"},
+	"clear_selection": {"esc"},
+	"bulk":            {"x"},
+	"pin":             {"*"},
+	"details":         {"v"},
+	"recent":          {"h"},
+	"palette":         {":", "ctrl+p"},
+	"help":            {"?"},
+	"choose":          {"enter"},
+	"back":            {"esc", "q"},
+	"confirm":         {"y", "Y", "enter"},
+	"deny":            {"n", "N", "esc"},
+}
+
+// KeyPresets are the named base keymaps; they override the default preset
+var KeyPresets = map[string]map[string][]string{
+	"default": {},
+	"vim": {
+		"page_up":   {"ctrl+u", "pgup"},
+		"page_down": {"ctrl+d", "pgdown"},
+		"top":       {"g", "home"},
+		"bottom":    {"G", "end"},
+	},
+	"emacs": {
+		"up":              {"up", "ctrl+p"},
+		"down":            {"down", "ctrl+n"},
+		"page_up":         {"pgup", "alt+v"},
+		"page_down":       {"pgdown", "ctrl+v"},
+		"top":             {"home", "alt+<"},
+		"bottom":          {"end", "alt+>"},
+		"search":          {"/", "ctrl+s"},
+		"save":            {"ctrl+x"},
+		"select":          {" ", "ctrl+@"},
+		"back":            {"esc", "q", "ctrl+g"},
+		"deny":            {"n", "N", "esc", "ctrl+g"},
+		"clear_selection": {"esc", "ctrl+g"},
+		"palette":         {":", "alt+x"},
+	},
+}
+
+// NewKeyMap builds the keymap of a preset (empty means default) with the
+// overrides from config.json applied. Unknown names and conflicting keys are
+// reported in the error; on conflicts the preset keymap is returned as is.
+func NewKeyMap(preset string, overrides map[string][]string) (KeyMap, error) {
+	var problems []string
+	if preset == "" {
+		preset = "default"
+	}
+	presetKeys, ok := KeyPresets[strings.ToLower(preset)]
+	if !ok {
+		problems = append(problems, i18n.T("keys.unknown_preset", preset))
+	}
+	keys := make(map[string][]string, len(defaultKeys))
+	for name, k := range defaultKeys {
+		keys[name] = k
+	}
+	for name, k := range presetKeys {
+		keys[name] = k
+	}
+	base := buildKeyMap(keys)
+
+	known := make(map[string]bool, len(keyActions))
+	for _, action := range keyActions {
+		known[action.name] = true
+	}
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !known[name] {
+			problems = append(problems, i18n.T("keys.unknown_action", name))
+			continue
+		}
+		if len(overrides[name]) == 0 {
+			problems = append(problems, i18n.T("keys.no_keys", name))
+			continue
+		}
+		keys[name] = normalizeKeys(overrides[name])
+	}
+
+	keymap := buildKeyMap(keys)
+	if conflicts := keymap.Conflicts(); len(conflicts) > 0 {
+		problems = append(problems, i18n.T("keys.conflicts", strings.Join(conflicts, "; ")))
+		keymap = base
+	}
+	if len(problems) > 0 {
+		return keymap, fmt.Errorf("%s", strings.Join(problems, ", "))
+	}
+	return keymap, nil
+}
+
+// DefaultKeyMap returns the default preset
+func DefaultKeyMap() KeyMap {
+	return buildKeyMap(defaultKeys)
+}
+
+func buildKeyMap(keys map[string][]string) KeyMap {
+	var k KeyMap
+	for _, action := range keyActions {
+		*action.binding(&k) = key.NewBinding(
+			key.WithKeys(keys[action.name]...),
+			key.WithHelp(keyLabel(keys[action.name]), i18n.T(action.help)),
+		)
+	}
+	return k
+}
+
+// normalizeKeys accepts "space" for the space bar
+func normalizeKeys(keys []string) []string {
+	normalized := make([]string, len(keys))
+	for i, k := range keys {
+		if strings.EqualFold(k, "space") {
+			k = " "
+		}
+		normalized[i] = k
+	}
+	return normalized
+}
+
+// keyLabel is the key shown in help, e.g. "↑/k"
+func keyLabel(keys []string) string {
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		switch k {
+		case " ":
+			k = "space"
+		case "up":
+			k = "↑"
+		case "down":
+			k = "↓"
+		case "left":
+			k = "←"
+		case "right":
+			k = "→"
+		}
+		labels[i] = k
+	}
+	return strings.Join(labels, "/")
+}
+
+// Conflicts lists keys bound to several actions of the same context,
+// e.g. `d: delete, pin`
+func (k KeyMap) Conflicts() []string {
+	var conflicts []string
+	for _, context := range []string{keyContextList, keyContextPicker, keyContextDialog} {
+		owners := make(map[string][]string)
+		var order []string
+		add := func(keyName, owner string) {
+			if _, seen := owners[keyName]; !seen {
+				order = append(order, keyName)
+			}
+			owners[keyName] = append(owners[keyName], owner)
+		}
+		add(forceQuitKey, "force_quit")
+		switch context {
+		case keyContextList:
+			for _, reserved := range reservedListKeys {
+				add(reserved, "quick_filter")
+			}
+		case keyContextPicker:
+			// Views never share a screen, so a key used by several views is
+			// one owner
+			views := make(map[string][]string)
+			var keys []string
+			for _, reserved := range reservedPickerKeys {
+				for _, keyName := range reserved.keys {
+					if _, seen := views[keyName]; !seen {
+						keys = append(keys, keyName)
+					}
+					views[keyName] = append(views[keyName], reserved.view)
+				}
+			}
+			for _, keyName := range keys {
+				add(keyName, strings.Join(views[keyName], "/"))
+			}
+		}
+		for _, action := range keyActions {
+			if !hasContext(action, context) {
+				continue
+			}
+			for _, keyName := range action.binding(&k).Keys() {
+				add(keyName, action.name)
+			}
+		}
+		for _, keyName := range order {
+			if len(owners[keyName]) > 1 {
+				conflicts = append(conflicts, fmt.Sprintf("%s: %s", keyLabel([]string{keyName}), strings.Join(owners[keyName], ", ")))
+			}
+		}
+	}
+	return conflicts
+}
+
+func hasContext(action keyAction, context string) bool {
+	for _, c := range action.contexts {
+		if c == context {
+			return true
+		}
+	}
+	return false
+}
+
+// isForceQuit reports whether the key always quits the program
+func isForceQuit(msg fmt.Stringer) bool {
+	return msg.String() == forceQuitKey
+}
+
+// listKeyMap adapts the keymap to the list component's own bindings
+func (k KeyMap) listKeyMap() list.KeyMap {
+	km := list.DefaultKeyMap()
+	km.CursorUp = k.Up
+	km.CursorDown = k.Down
+	km.PrevPage = k.PageUp
+	km.NextPage = k.PageDown
+	km.GoToStart = k.Top
+	km.GoToEnd = k.Bottom
+	km.Quit = k.Quit
+	km.ForceQuit = key.NewBinding(key.WithKeys(forceQuitKey))
+	// The help overlay replaces the list's own full help
+	km.ShowFullHelp = k.Help
+	km.CloseFullHelp.SetEnabled(false)
+	// Filtering is handled by the search input
+	km.Filter.SetEnabled(false)
+	km.ClearFilter.SetEnabled(false)
+	return km
+}
+
+// backupListKeyMap adapts the keymap to the backup browser, which filters its
+// list with the search key; going back and quitting are handled by the browser
+func (k KeyMap) backupListKeyMap() list.KeyMap {
+	km := k.listKeyMap()
+	km.Filter = k.Search
+	km.Quit.SetEnabled(false)
+	km.ShowFullHelp.SetEnabled(false)
+	return km
+}
+
+// shortHelp lists the list actions shown in the help line
+func (k KeyMap) shortHelp() []key.Binding {
+	return []key.Binding{
+		k.Copy, k.Add, k.Search, k.Delete, k.Open, k.Import, k.Paste, k.Backups,
+		k.Filters, k.SaveFilter, k.Sort, k.Group, k.Select, k.Bulk, k.Pin,
+		k.Details, k.Recent, k.Refresh, k.Palette,
+	}
+}
@@ -3,17 +3,24 @@ package ui
 import (
 	"fmt"
 	"io"
+	"strings"
 
+	"gotickets/internal/i18n"
 	"gotickets/internal/storage"
 
-	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 // ticketDelegate implements the list.ItemDelegate interface for rendering tickets
-type ticketDelegate struct{}
+type ticketDelegate struct {
+	// matches holds fuzzy search matches by ticket ID for highlighting
+	matches map[int]storage.SearchMatch
+	// selection holds the IDs of tickets marked for a bulk action
+	selection map[int]bool
+	styles    Styles
+}
 
 func (d ticketDelegate) Height() int                               { return 1 }
 func (d ticketDelegate) Spacing() int                              { return 0 }
@@ -29,74 +36,354 @@ func (d ticketDelegate) Render(w io.Writer, m list.Model, index int, listItem li
 	ticketNum := ticket.ExtractTicketNumber()
 	str := fmt.Sprintf("SCR #%s - %s", ticketNum, ticket.Title)
 
+	base := lipgloss.NewStyle()
+	highlight := d.styles.Match
+	if index == m.Index() {
+		base = d.styles.Selected
+		highlight = base.Bold(true).Underline(true)
+	}
+	if match, ok := d.matches[ticket.ID]; ok {
+		str = highlightMatch(str, match, len("SCR #"), len("SCR #"+ticketNum+" - "), highlight, base)
+	} else {
+		str = base.Render(str)
+	}
+	if ticket.Pinned {
+		str = base.Inherit(d.styles.Pin).Render("★ ") + str
+	}
+	if len(d.selection) > 0 {
+		// Markers only appear while something is selected, so the list does not shift otherwise
+		if d.selection[ticket.ID] {
+			str = base.Inherit(d.styles.Marked).Render("◉ ") + str
+		} else {
+			str = base.Render("○ ") + str
+		}
+	}
+
 	if index == m.Index() {
-		fmt.Fprint(w, lipgloss.NewStyle().
-			Foreground(lipgloss.Color("0")).
-			Background(lipgloss.Color("12")).
-			Padding(0, 1).
-			Render("> "+str))
+		fmt.Fprint(w, base.Padding(0, 1).Render(base.Render("> ")+str))
 	} else {
 		fmt.Fprint(w, lipgloss.NewStyle().PaddingLeft(4).Render(str))
 	}
 }
 
+// highlightMatch styles the matched characters of a rendered ticket line.
+// numberOffset and titleOffset are the byte positions of the fields in str.
+func highlightMatch(str string, match storage.SearchMatch, numberOffset, titleOffset int, matched, unmatched lipgloss.Style) string {
+	var offset int
+	switch match.Field {
+	case storage.FieldNumber:
+		offset = numberOffset
+	case storage.FieldTitle:
+		offset = titleOffset
+	default:
+		// The URL is not part of the line
+		return unmatched.Render(str)
+	}
+
+	return styleMatched(str, match.MatchedIndexes, offset, matched, unmatched)
+}
+
+// styleMatched styles the characters at the given byte offsets (shifted by offset) of str
+func styleMatched(str string, byteIndexes []int, offset int, matched, unmatched lipgloss.Style) string {
+	// StyleRunes expects rune indexes, the matcher reports byte offsets
+	positions := make(map[int]bool, len(byteIndexes))
+	for _, i := range byteIndexes {
+		positions[offset+i] = true
+	}
+	var runeIndexes []int
+	runeIndex := 0
+	for byteIndex := range str {
+		if positions[byteIndex] {
+			runeIndexes = append(runeIndexes, runeIndex)
+		}
+		runeIndex++
+	}
+	return lipgloss.StyleRunes(str, runeIndexes, matched, unmatched)
+}
+
+// groupHeader is a list item that starts a group of tickets in the grouped view
+type groupHeader struct {
+	name      string
+	count     int
+	collapsed bool
+}
+
+// FilterValue implements bubbles list.Item interface
+func (h groupHeader) FilterValue() string { return h.name }
+
+// groupedDelegate renders group headers and indents the tickets under them
+type groupedDelegate struct {
+	ticketDelegate
+}
+
+func (d groupedDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	header, ok := listItem.(groupHeader)
+	if !ok {
+		fmt.Fprint(w, "  ")
+		d.ticketDelegate.Render(w, m, index, listItem)
+		return
+	}
+
+	arrow := "▾"
+	if header.collapsed {
+		arrow = "▸"
+	}
+	name := header.name
+	if name == "" {
+		name = i18n.T("list.no_group")
+	}
+	str := fmt.Sprintf("%s %s (%d)", arrow, name, header.count)
+	if index == m.Index() {
+		fmt.Fprint(w, d.styles.Selected.Bold(true).Padding(0, 1).Render("> "+str))
+	} else {
+		fmt.Fprint(w, d.styles.GroupHeader.PaddingLeft(2).Render(str))
+	}
+}
+
 // createList creates and configures the main ticket list
-func createList(items []list.Item, ticketCount int) list.Model {
-	l := list.New(items, ticketDelegate{}, 80, 24)
-	l.Title = fmt.Sprintf("%s\nВсего тикетов: %d",
+func createList(items []list.Item, ticketCount int, keys KeyMap, styles Styles) list.Model {
+	l := list.New(items, ticketDelegate{styles: styles}, 80, 24)
+	l.Title = fmt.Sprintf("%s\n%s",
 		lipgloss.NewStyle().Bold(true).Render("GoTickets - Ticket Manager"),
-		ticketCount)
+		i18n.T("list.total", ticketCount))
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(false) // We'll handle search manually
-	l.Styles.Title = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("62")).
-		Padding(0, 1).
-		Align(lipgloss.Center)
-
-	// Set additional help keys
-	l.AdditionalShortHelpKeys = func() []key.Binding {
-		return []key.Binding{
-			key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "copy url")),
-			key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "add")),
-			key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
-			key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "delete")),
-			key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "open")),
-			key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "import")),
-			key.NewBinding(key.WithKeys("b"), key.WithHelp("b", "backups")),
-			key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
-		}
-	}
+	l.Styles.Title = styles.Title
+
+	l.KeyMap = keys.listKeyMap()
+	l.AdditionalShortHelpKeys = keys.shortHelp
 
 	return l
 }
 
-// RefreshList updates the list with current tickets
+// RefreshList shows all current tickets and drops the active search
 func (m *Model) RefreshList() {
-	items := make([]list.Item, len(m.storage.Tickets))
-	for i, ticket := range m.storage.Tickets {
-		items[i] = ticket
+	active := m.storage.ActiveTickets()
+	m.setTicketItems(storage.SortTickets(active, m.sortMode), nil)
+	m.searchQuery = ""
+	m.activeSearch = ""
+	status := i18n.T("list.total", len(active))
+	if archived := len(m.storage.Tickets) - len(active); archived > 0 {
+		status += " · " + i18n.T("list.archived", archived)
 	}
-	m.list.SetItems(items)
-	m.list.Title = fmt.Sprintf("%s\nВсего тикетов: %d",
-		lipgloss.NewStyle().Bold(true).Render("GoTickets - Ticket Manager"),
-		len(m.storage.Tickets))
+	m.setListTitle(status)
 }
 
-// FilterList filters the list based on query
+// FilterList filters the list based on query. Plain text is ranked by fuzzy
+// search unless a sort mode is chosen; structured queries (fields, negation,
+// phrases, OR) are listed in sort order. On a parse error the list is left
+// as is and the error is shown under the input.
 func (m *Model) FilterList(query string) {
-	if query == "" {
+	if strings.TrimSpace(query) == "" {
+		m.searchError = ""
 		m.RefreshList()
 		return
 	}
 
-	filteredTickets := m.storage.Search(query)
-	items := make([]list.Item, len(filteredTickets))
-	for i, ticket := range filteredTickets {
-		items[i] = ticket
+	q, err := storage.ParseQuery(query)
+	if err != nil {
+		m.searchError = err.Error()
+		return
+	}
+	m.searchError = ""
+
+	var tickets []storage.Ticket
+	byID := make(map[int]storage.SearchMatch)
+	if q.IsPlain() {
+		for _, match := range m.storage.SearchMatches(q.PlainText()) {
+			tickets = append(tickets, match.Ticket)
+			byID[match.Ticket.ID] = match
+		}
+		if m.sortMode != storage.SortAdded {
+			tickets = storage.SortTickets(tickets, m.sortMode)
+		}
+	} else {
+		for _, ticket := range storage.SortTickets(m.storage.Tickets, m.sortMode) {
+			if q.Match(ticket) {
+				tickets = append(tickets, ticket)
+			}
+		}
+	}
+	listed := m.setTicketItems(tickets, byID)
+	// Archived tickets can only be listed by queries using archived:
+	total := len(m.storage.ActiveTickets())
+	if q.HasField("archived") {
+		total = len(m.storage.Tickets)
+	}
+	shown := i18n.T("list.shown", listed, total)
+	if m.activeSearch != "" {
+		shown = i18n.T("list.filter", m.activeSearch) + " · " + shown
+	}
+	m.setListTitle(shown)
+}
+
+// pinnedGroupName is the header of the pinned section in the grouped view
+func pinnedGroupName() string {
+	return "★ " + i18n.T("list.pinned")
+}
+
+// setTicketItems fills the list with tickets, split under group headers
+// when a group mode is active. Collapsed groups only show their header.
+// Pinned tickets are always listed first, whatever the sort, filter or grouping.
+// It returns the number of tickets listed, pinned ones included.
+func (m *Model) setTicketItems(tickets []storage.Ticket, matches map[int]storage.SearchMatch) int {
+	m.listMatches = matches
+	m.listResult = make(map[int]bool, len(tickets))
+	for _, ticket := range tickets {
+		m.listResult[ticket.ID] = true
+	}
+	defer m.applyDelegate()
+
+	pinned := storage.SortTickets(m.storage.PinnedTickets(), m.sortMode)
+	rest := make([]storage.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		if !ticket.Pinned {
+			rest = append(rest, ticket)
+		}
+	}
+
+	var items []list.Item
+	if m.groupMode == storage.GroupNone {
+		for _, ticket := range append(pinned, rest...) {
+			items = append(items, ticket)
+		}
+		m.list.SetItems(items)
+		return len(pinned) + len(rest)
+	}
+
+	groups := storage.GroupTickets(rest, m.groupMode)
+	if len(pinned) > 0 {
+		groups = append([]storage.TicketGroup{{Name: pinnedGroupName(), Tickets: pinned}}, groups...)
+	}
+	for _, group := range groups {
+		collapsed := m.collapsedGroups[group.Name]
+		items = append(items, groupHeader{name: group.Name, count: len(group.Tickets), collapsed: collapsed})
+		if collapsed {
+			continue
+		}
+		for _, ticket := range group.Tickets {
+			items = append(items, ticket)
+		}
 	}
 	m.list.SetItems(items)
-	m.list.Title = fmt.Sprintf("%s\nПоказано: %d из %d",
-		lipgloss.NewStyle().Bold(true).Render("GoTickets - Ticket Manager"),
-		len(filteredTickets), len(m.storage.Tickets))
+	return len(pinned) + len(rest)
+}
+
+// applyDelegate sets the list delegate for the current search matches, selection and group mode
+func (m *Model) applyDelegate() {
+	delegate := ticketDelegate{matches: m.listMatches, selection: m.selection, styles: m.styles}
+	if m.groupMode == storage.GroupNone {
+		m.list.SetDelegate(delegate)
+	} else {
+		m.list.SetDelegate(groupedDelegate{delegate})
+	}
+}
+
+// setListTitle sets the list title with a status line below the app name.
+// The sort mode is shown unless tickets are in the order they were added.
+func (m *Model) setListTitle(status string) {
+	if m.sortMode != storage.SortAdded {
+		status += " · " + i18n.T("list.sort", m.sortMode.Label())
+	}
+	if m.groupMode != storage.GroupNone {
+		status += " · " + i18n.T("list.groups", m.groupMode.Label())
+	}
+	m.list.Title = fmt.Sprintf("%s\n%s",
+		lipgloss.NewStyle().Bold(true).Render("GoTickets - Ticket Manager"), status)
+}
+
+// reapplyFilter redraws the list with the current search, keeping the selected ticket
+func (m *Model) reapplyFilter() {
+	selected, hasSelection := m.list.SelectedItem().(storage.Ticket)
+	if m.searchQuery != "" {
+		m.FilterList(m.searchQuery)
+	} else {
+		m.RefreshList()
+	}
+	if hasSelection {
+		m.selectTicket(selected.ID)
+	}
+}
+
+// handleCycleGroup switches to the next group mode
+func (m Model) handleCycleGroup() (Model, tea.Cmd) {
+	return m.setGroupMode(m.groupMode.Next())
+}
+
+// setGroupMode groups the list by the given mode
+func (m Model) setGroupMode(mode storage.GroupMode) (Model, tea.Cmd) {
+	newModel := m
+	newModel.groupMode = mode
+	newModel.reapplyFilter()
+	return newModel, newModel.notifyInfo(i18n.T("list.grouping", newModel.groupMode.Label()))
+}
+
+// toggleGroup collapses or expands the group under the cursor
+func (m Model) toggleGroup(header groupHeader) (Model, tea.Cmd) {
+	newModel := m
+	collapsed := make(map[string]bool, len(m.collapsedGroups)+1)
+	for name, c := range m.collapsedGroups {
+		collapsed[name] = c
+	}
+	if header.collapsed {
+		delete(collapsed, header.name)
+	} else {
+		collapsed[header.name] = true
+	}
+	newModel.collapsedGroups = collapsed
+	newModel.reapplyFilter()
+	newModel.selectGroup(header.name)
+	return newModel, nil
+}
+
+// selectGroup moves the cursor to the header of the named group
+func (m *Model) selectGroup(name string) {
+	for i, item := range m.list.Items() {
+		if header, ok := item.(groupHeader); ok && header.name == name {
+			m.list.Select(i)
+			return
+		}
+	}
+}
+
+// handleTogglePin pins or unpins the ticket under the cursor
+func (m Model) handleTogglePin() (Model, tea.Cmd) {
+	ticket, ok := m.list.SelectedItem().(storage.Ticket)
+	if !ok {
+		return m, nil
+	}
+	newModel := m
+	if err := newModel.storage.SetPinned(ticket.ID, !ticket.Pinned); err != nil {
+		return newModel, newModel.notifyWarning(i18n.T("ticket.already_deleted"))
+	}
+	cmd := newModel.saveStorage()
+	newModel.reapplyFilter()
+	text := i18n.T("ticket.pinned")
+	if ticket.Pinned {
+		text = i18n.T("ticket.unpinned")
+	}
+	return newModel, tea.Batch(cmd, newModel.notifyInfo(text))
+}
+
+// handleCycleSort switches to the next sort mode
+func (m Model) handleCycleSort() (Model, tea.Cmd) {
+	return m.setSortMode(m.sortMode.Next())
+}
+
+// setSortMode sorts the list by the given mode
+func (m Model) setSortMode(mode storage.SortMode) (Model, tea.Cmd) {
+	newModel := m
+	newModel.sortMode = mode
+	newModel.reapplyFilter()
+	return newModel, newModel.notifyInfo(i18n.T("list.sort", newModel.sortMode.Label()))
+}
+
+// selectTicket moves the cursor to the ticket with the given ID if it is visible
+func (m *Model) selectTicket(id int) {
+	for i, item := range m.list.Items() {
+		if ticket, ok := item.(storage.Ticket); ok && ticket.ID == id {
+			m.list.Select(i)
+			return
+		}
+	}
 }
@@ -1,7 +1,13 @@
 package ui
 
 import (
+	"os"
+	"time"
+
+	"gotickets/internal/config"
+	"gotickets/internal/i18n"
 	"gotickets/internal/storage"
+	"gotickets/internal/watch"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -20,28 +26,92 @@ const (
 	ViewImportResult
 	ViewBackups
 	ViewConfirmRestore
+	ViewImportPreview
+	ViewSavedSearches
+	ViewSaveSearch
+	ViewBulkActions
+	ViewBulkInput
+	ViewConfirmBulk
+	ViewRecent
+	ViewDetail
+	ViewHelp
+	ViewPalette
+	ViewConfirmReload
+	ViewBackupInput
+	ViewConfirmBackupDelete
 )
 
 // Model represents the main application state
 type Model struct {
-	storage             *storage.TicketStorage
-	viewMode            ViewMode
-	list                list.Model
-	textInput           textinput.Model
-	searchMode          bool
-	searchQuery         string
-	tempURL             string
-	ticketToDelete      int
-	importResult        *storage.ImportResult
-	backups             []string
-	backupToRestore     string
-	selectedBackupIndex int
-	urlError            string
+	config             *config.Config
+	keys               KeyMap
+	styles             Styles
+	storage            *storage.TicketStorage
+	viewMode           ViewMode
+	list               list.Model
+	textInput          textinput.Model
+	formatInput        textinput.Model
+	formatFocused      bool
+	importFormatError  string
+	searchMode         bool
+	searchQuery        string
+	searchError        string
+	activeSearch       string
+	selectedSavedIndex int
+	sortMode           storage.SortMode
+	groupMode          storage.GroupMode
+	collapsedGroups    map[string]bool
+	listMatches        map[int]storage.SearchMatch
+	listResult         map[int]bool
+	selection          map[int]bool
+	rangeAnchor        int
+	bulkAction         bulkAction
+	bulkArg            string
+	recent             []storage.Ticket
+	recentIndex        int
+	detailID           int
+	detailReturn       ViewMode
+	helpReturn         ViewMode
+	helpOffset         int
+	paletteMatches     []paletteMatch
+	paletteIndex       int
+	lastClickView      ViewMode
+	lastClickIndex     int
+	lastClickAt        time.Time
+	reloadReturn       ViewMode
+	watcher            *watch.Watcher
+	tempURL            string
+	tempResolved       storage.ResolvedURL
+	ticketToDelete     int
+	importResult       *storage.ImportResult
+	importPreview      *storage.ImportPreview
+	previewIndex       int
+	previewEditing     bool
+	backupList         list.Model
+	backupInput        backupInputAction
+	backupToRestore    string
+	backupToDelete     string
+	urlError           string
+	toasts             []toast
+	nextToastID        int
+	dirty              bool
+	initCmd            tea.Cmd
 }
 
 // NewModel creates and initializes a new application model
 func NewModel() Model {
-	ticketStorage, _ := storage.LoadTicketsWithFS(&storage.RealFileSystem{})
+	return NewModelWithFS(&storage.RealFileSystem{})
+}
+
+// NewModelWithFS creates the application model on top of the given file system
+// and restores the UI state saved by the previous run
+func NewModelWithFS(fs storage.FileSystem) Model {
+	cfg, _ := config.Load(fs)
+	langErr := i18n.Setup(cfg.Language, os.Getenv)
+	// Load the config again so its errors are in the chosen language
+	cfg, cfgErr := config.Load(fs)
+	ticketStorage, _ := storage.LoadTicketsWithFS(fs)
+	ticketStorage.SetURLPolicy(cfg.URLPolicy())
 
 	// Convert tickets to list items
 	items := make([]list.Item, len(ticketStorage.Tickets))
@@ -49,22 +119,51 @@ func NewModel() Model {
 		items[i] = ticket
 	}
 
+	keys, keysErr := NewKeyMap(cfg.KeyPreset, cfg.Keys)
+	theme, themeErr := ResolveTheme(cfg.Theme, envNoColor())
+	styles := NewStyles(theme)
+
 	// Create list with custom delegate
-	listComponent := createList(items, len(ticketStorage.Tickets))
+	listComponent := createList(items, len(ticketStorage.Tickets), keys, styles)
 
 	// Create text input component
 	textInputComponent := createTextInput()
-
-	return Model{
-		storage:             ticketStorage,
-		viewMode:            ViewList,
-		list:                listComponent,
-		textInput:           textInputComponent,
-		ticketToDelete:      -1,
-		backups:             []string{},
-		backupToRestore:     "",
-		selectedBackupIndex: -1,
+	formatInputComponent := createFormatInput()
+
+	m := Model{
+		config:          cfg,
+		keys:            keys,
+		styles:          styles,
+		storage:         ticketStorage,
+		viewMode:        ViewList,
+		list:            listComponent,
+		textInput:       textInputComponent,
+		formatInput:     formatInputComponent,
+		ticketToDelete:  -1,
+		backupList:      newBackupList(keys, styles),
+		backupToRestore: "",
+		sortMode:        storage.SortAdded,
+	}
+	var initCmds []tea.Cmd
+	if cfgErr != nil {
+		initCmds = append(initCmds, m.notifyWarning(i18n.T("ui.config_defaults", cfgErr)))
 	}
+	if langErr != nil {
+		initCmds = append(initCmds, m.notifyWarning(langErr.Error()))
+	}
+	if keysErr != nil {
+		initCmds = append(initCmds, m.notifyWarning(i18n.T("ui.keys_warning", keysErr)))
+	}
+	if themeErr != nil {
+		initCmds = append(initCmds, m.notifyWarning(themeErr.Error()))
+	}
+	if state, err := storage.LoadState(fs); err != nil {
+		initCmds = append(initCmds, m.notifyWarning(i18n.T("ui.state_restore_failed", err)))
+	} else {
+		m.restoreState(state)
+	}
+	m.initCmd = tea.Batch(initCmds...)
+	return m
 }
 
 // GetViewMode returns the current view mode
@@ -92,22 +191,34 @@ func (m Model) IsSearchMode() bool {
 	return m.searchMode
 }
 
-// SetListSize sets the size of the list component
+// SetListSize sets the size of the list component, keeping the selected ticket under the cursor
 func (m *Model) SetListSize(width, height int) {
+	selected, hasSelection := m.list.SelectedItem().(storage.Ticket)
 	m.list.SetWidth(width)
 	m.list.SetHeight(height)
+	m.backupList.SetSize(width, height)
+	if hasSelection {
+		m.selectTicket(selected.ID)
+	}
 }
 
-// UpdateList updates the list component with a message
+// UpdateList updates the list component with a message. Filter results go to
+// the backup browser, the only list filtered by the list component itself.
 func (m *Model) UpdateList(msg tea.Msg) {
 	var cmd tea.Cmd
+	if _, ok := msg.(list.FilterMatchesMsg); ok {
+		m.backupList, cmd = m.backupList.Update(msg)
+		_ = cmd
+		return
+	}
 	m.list, cmd = m.list.Update(msg)
 	_ = cmd // Ignore command for now
 }
 
-// Init implements tea.Model interface
+// Init implements tea.Model interface: it returns the commands queued while
+// the model was created and starts waiting for changes of tickets.json
 func (m Model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.initCmd, m.waitForTicketsChange())
 }
 
 // Update implements tea.Model interface - this will be overridden in main
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"gotickets/internal/storage"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// doubleClickInterval is the longest pause between the clicks of a double click
+const doubleClickInterval = 400 * time.Millisecond
+
+// MouseEnabled reports whether the program should capture mouse events
+func (m Model) MouseEnabled() bool {
+	return m.config.MouseEnabled()
+}
+
+// HandleMouse handles clicks and the scroll wheel in the list, the backup
+// list and the confirmation dialogs; other views ignore the mouse
+func (m Model) HandleMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+	switch m.viewMode {
+	case ViewList:
+		return m.handleListMouse(msg)
+	case ViewBackups:
+		return m.handleBackupsMouse(msg)
+	case ViewConfirmDelete, ViewConfirmRestore, ViewConfirmBulk, ViewConfirmReload, ViewConfirmBackupDelete:
+		return m.handleDialogMouse(msg)
+	}
+	return m, nil
+}
+
+// isDoubleClick records a click on an item and reports whether it completes a double click
+func (m *Model) isDoubleClick(index int) bool {
+	now := time.Now()
+	double := m.lastClickView == m.viewMode && m.lastClickIndex == index &&
+		now.Sub(m.lastClickAt) <= doubleClickInterval
+	if double {
+		// A third click starts over
+		m.lastClickAt = time.Time{}
+	} else {
+		m.lastClickAt = now
+	}
+	m.lastClickView = m.viewMode
+	m.lastClickIndex = index
+	return double
+}
+
+// listItemsTop returns the screen row of the first list item. It measures the
+// title bar the way the list component renders it: the title is truncated to
+// the list width, one column is kept for the spinner.
+func (m Model) listItemsTop() int {
+	title := ansi.Truncate(m.list.Styles.Title.Render(m.list.Title)+"  ", m.list.Width()-1, "…")
+	return lipgloss.Height(m.list.Styles.TitleBar.Render(title))
+}
+
+// listItemAt returns the index of the list item shown on a screen row
+func (m Model) listItemAt(y int) (int, bool) {
+	row := y - m.listItemsTop()
+	if row < 0 || row >= m.list.Paginator.PerPage {
+		return 0, false
+	}
+	index := m.list.Paginator.Page*m.list.Paginator.PerPage + row
+	if index >= len(m.list.Items()) {
+		return 0, false
+	}
+	return index, true
+}
+
+func (m Model) handleListMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	newModel := m
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		newModel.list.CursorUp()
+	case tea.MouseButtonWheelDown:
+		newModel.list.CursorDown()
+	case tea.MouseButtonLeft:
+		index, ok := m.listItemAt(msg.Y)
+		if !ok {
+			return newModel, nil
+		}
+		newModel.list.Select(index)
+		if !newModel.isDoubleClick(index) {
+			return newModel, nil
+		}
+		switch item := newModel.list.SelectedItem().(type) {
+		case groupHeader:
+			return newModel.toggleGroup(item)
+		case storage.Ticket:
+			return newModel.openTicket(item)
+		}
+	}
+	return newModel, nil
+}
+
+// backupItemAt returns the index of the backup shown on a screen row; a backup
+// takes the rows of the delegate followed by the spacing between items
+func (m Model) backupItemAt(y int) (int, bool) {
+	row := y - strings.Count(m.backupsHeader(), "\n")
+	delegate := newBackupDelegate(m.styles)
+	slot := row / (delegate.Height() + delegate.Spacing())
+	if row < 0 || row%(delegate.Height()+delegate.Spacing()) >= delegate.Height() || slot >= m.backupList.Paginator.PerPage {
+		return 0, false
+	}
+	index := m.backupList.Paginator.Page*m.backupList.Paginator.PerPage + slot
+	if index >= len(m.backupList.VisibleItems()) {
+		return 0, false
+	}
+	return index, true
+}
+
+func (m Model) handleBackupsMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	newModel := m
+	if m.backupList.SettingFilter() {
+		return newModel, nil
+	}
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		newModel.backupList.CursorUp()
+	case tea.MouseButtonWheelDown:
+		newModel.backupList.CursorDown()
+	case tea.MouseButtonLeft:
+		index, ok := m.backupItemAt(msg.Y)
+		if !ok {
+			return newModel, nil
+		}
+		newModel.backupList.Select(index)
+		if newModel.isDoubleClick(index) {
+			return newModel.askRestore()
+		}
+	}
+	return newModel, nil
+}
+
+// confirmDialog returns the text and the answers of the current confirmation dialog
+func (m Model) confirmDialog() (string, []string) {
+	switch m.viewMode {
+	case ViewConfirmDelete:
+		return m.confirmDeleteDialog()
+	case ViewConfirmRestore:
+		return m.confirmRestoreDialog()
+	case ViewConfirmReload:
+		return m.confirmReloadDialog()
+	case ViewConfirmBackupDelete:
+		return m.confirmBackupDeleteDialog()
+	default:
+		return m.confirmBulkDialog()
+	}
+}
+
+// handleDialogMouse answers a confirmation dialog by a click on one of its answers
+func (m Model) handleDialogMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	body, answers := m.confirmDialog()
+	answer, ok := m.keyHelpAt(msg.X, msg.Y-strings.Count(body, "\n"), answers)
+	if !ok {
+		return m, nil
+	}
+	// The first answer confirms, the second one cancels
+	confirmed := answer == 0
+	switch m.viewMode {
+	case ViewConfirmDelete:
+		return m.answerDelete(confirmed)
+	case ViewConfirmRestore:
+		return m.answerRestore(confirmed)
+	case ViewConfirmReload:
+		return m.answerReload(confirmed)
+	case ViewConfirmBackupDelete:
+		return m.answerBackupDelete(confirmed)
+	default:
+		return m.answerBulk(confirmed)
+	}
+}
+
+// keyHelpAt returns the index of the key help pair at a position relative to
+// the top left corner of a help box rendered by formatKeyHelp
+func (m Model) keyHelpAt(x, y int, pairs []string) (int, bool) {
+	style := m.styles.Help
+	if y != style.GetMarginTop()+style.GetBorderTopSize()+style.GetPaddingTop() {
+		return 0, false
+	}
+	x -= style.GetMarginLeft() + style.GetBorderLeftSize() + style.GetPaddingLeft()
+	for i := 0; i+1 < len(pairs); i += 2 {
+		width := lipgloss.Width(pairs[i] + " - " + pairs[i+1])
+		if x >= 0 && x < width {
+			return i / 2, true
+		}
+		x -= width + lipgloss.Width(" • ")
+	}
+	return 0, false
+}
@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"strings"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sahilm/fuzzy"
+)
+
+// maxPaletteRows limits the number of commands shown at once
+const maxPaletteRows = 10
+
+// paletteCommand is an action that can be run from the command palette
+type paletteCommand struct {
+	title string
+	// keys is the shortcut shown next to the title, empty if there is none
+	keys string
+	run  func(Model) (Model, tea.Cmd)
+}
+
+// paletteMatch is a command matching the palette query
+type paletteMatch struct {
+	command paletteCommand
+	// matchedIndexes are byte offsets of the matched characters in the title
+	matchedIndexes []int
+}
+
+// paletteCommands lists every action available from the palette
+func (m Model) paletteCommands() []paletteCommand {
+	k := m.keys
+	bound := func(b key.Binding, title string, run func(Model) (Model, tea.Cmd)) paletteCommand {
+		return paletteCommand{title: title, keys: b.Help().Key, run: run}
+	}
+	commands := []paletteCommand{
+		bound(k.Add, i18n.T("palette.add"), Model.handleAddTicket),
+		bound(k.Import, i18n.T("palette.import"), Model.handleImport),
+		bound(k.Paste, i18n.T("palette.paste"), Model.handlePasteBulk),
+		{title: i18n.T("palette.export"), run: Model.handlePaletteExport},
+		bound(k.Backups, i18n.T("palette.backups"), Model.handleBackups),
+		bound(k.Search, i18n.T("palette.search"), Model.handleSearch),
+		bound(k.Filters, i18n.T("palette.filters"), Model.handleSavedSearches),
+		bound(k.SaveFilter, i18n.T("palette.save_filter"), Model.handleSaveSearch),
+		bound(k.Recent, i18n.T("palette.recent"), Model.handleRecent),
+		bound(k.Details, i18n.T("palette.details"), func(m Model) (Model, tea.Cmd) {
+			if ticket, ok := m.list.SelectedItem().(storage.Ticket); ok {
+				return m.showDetail(ticket.ID)
+			}
+			return m, nil
+		}),
+		bound(k.Pin, i18n.T("palette.pin"), Model.handleTogglePin),
+		bound(k.Bulk, i18n.T("palette.bulk"), Model.handleBulkMenu),
+		bound(k.SelectAll, i18n.T("palette.select_all"), Model.handleSelectAll),
+		bound(k.ClearSelection, i18n.T("palette.clear_selection"), Model.handleClearSelection),
+		bound(k.Refresh, i18n.T("palette.refresh"), func(m Model) (Model, tea.Cmd) {
+			m.RefreshList()
+			return m, nil
+		}),
+		bound(k.Save, i18n.T("palette.save"), Model.handleRetrySave),
+		bound(k.Help, i18n.T("palette.help"), Model.showHelp),
+		bound(k.Quit, i18n.T("palette.quit"), Model.handleQuit),
+	}
+	for _, mode := range storage.SortModes {
+		commands = append(commands, paletteCommand{
+			title: i18n.T("palette.sort", mode.Label()),
+			run:   func(m Model) (Model, tea.Cmd) { return m.setSortMode(mode) },
+		})
+	}
+	for _, mode := range storage.GroupModes {
+		commands = append(commands, paletteCommand{
+			title: i18n.T("palette.group", mode.Label()),
+			run:   func(m Model) (Model, tea.Cmd) { return m.setGroupMode(mode) },
+		})
+	}
+	for _, name := range ThemeNames() {
+		commands = append(commands, paletteCommand{
+			title: i18n.T("palette.theme", name),
+			run:   func(m Model) (Model, tea.Cmd) { return m.handleSetTheme(name) },
+		})
+	}
+	return commands
+}
+
+// commandTitles is a fuzzy.Source over the command titles
+type commandTitles []paletteCommand
+
+func (c commandTitles) Len() int            { return len(c) }
+func (c commandTitles) String(i int) string { return c[i].title }
+
+// filterPalette fuzzy-matches the query against the command titles, best first.
+// An empty query lists every command.
+func (m *Model) filterPalette(query string) {
+	commands := m.paletteCommands()
+	var matches []paletteMatch
+	if strings.TrimSpace(query) == "" {
+		for _, command := range commands {
+			matches = append(matches, paletteMatch{command: command})
+		}
+	} else {
+		for _, match := range fuzzy.FindFrom(query, commandTitles(commands)) {
+			matches = append(matches, paletteMatch{
+				command:        commands[match.Index],
+				matchedIndexes: match.MatchedIndexes,
+			})
+		}
+	}
+	m.paletteMatches = matches
+	m.paletteIndex = 0
+}
+
+// handlePalette opens the command palette
+func (m Model) handlePalette() (Model, tea.Cmd) {
+	newModel := m
+	newModel.textInput.SetValue("")
+	newModel.textInput.Placeholder = i18n.T("palette.placeholder")
+	newModel.textInput.Focus()
+	newModel.filterPalette("")
+	newModel.SetViewMode(ViewPalette)
+	return newModel, nil
+}
+
+// HandlePalette handles the command palette: typing filters, Enter runs the command
+func (m Model) HandlePalette(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	newModel := m
+	count := len(m.paletteMatches)
+
+	switch msg.String() {
+	case "ctrl+c":
+		return newModel, tea.Quit
+	case "esc":
+		newModel.SetViewMode(ViewList)
+		newModel.ClearTextInput()
+		return newModel, nil
+	case "up", "ctrl+p":
+		if count > 0 {
+			newModel.paletteIndex = (newModel.paletteIndex - 1 + count) % count
+		}
+		return newModel, nil
+	case "down", "ctrl+n", "tab":
+		if count > 0 {
+			newModel.paletteIndex = (newModel.paletteIndex + 1) % count
+		}
+		return newModel, nil
+	case "enter":
+		if count == 0 {
+			return newModel, nil
+		}
+		command := m.paletteMatches[m.paletteIndex].command
+		newModel.SetViewMode(ViewList)
+		newModel.ClearTextInput()
+		newModel.paletteMatches = nil
+		return command.run(newModel)
+	}
+
+	previous := newModel.textInput.Value()
+	newModel.textInput, cmd = newModel.textInput.Update(msg)
+	if newModel.textInput.Value() != previous {
+		newModel.filterPalette(newModel.textInput.Value())
+	}
+	return newModel, cmd
+}
+
+// handlePaletteExport exports the selected tickets, or every visible ticket
+// matching the search if nothing is selected; the exported tickets stay selected
+func (m Model) handlePaletteExport() (Model, tea.Cmd) {
+	newModel := m
+	if len(m.selectedIDs()) == 0 {
+		ids := m.matchingTicketIDs()
+		if len(ids) == 0 {
+			return newModel, newModel.notifyWarning(i18n.T("palette.nothing_to_export"))
+		}
+		selection := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			selection[id] = true
+		}
+		newModel.setSelection(selection)
+	}
+	return newModel.askBulkArg(bulkExport, i18n.T("bulk.placeholder.export"))
+}
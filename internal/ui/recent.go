@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"time"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxRecentTickets limits the recent view
+const maxRecentTickets = 20
+
+// relativeTime formats a moment in the past as "5 мин назад", "2 ч назад" and so on
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return i18n.T("time.just_now")
+	case d < time.Hour:
+		return i18n.T("time.minutes_ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return i18n.T("time.hours_ago", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		return i18n.T("time.days_ago", int(d.Hours()/24))
+	default:
+		return t.Format("02.01.2006")
+	}
+}
+
+// handleRecent shows the most recently opened or copied tickets
+func (m Model) handleRecent() (Model, tea.Cmd) {
+	newModel := m
+	newModel.recent = m.storage.RecentTickets(maxRecentTickets)
+	if len(newModel.recent) == 0 {
+		return newModel, newModel.notifyInfo(i18n.T("recent.empty"))
+	}
+	newModel.recentIndex = 0
+	newModel.SetViewMode(ViewRecent)
+	return newModel, nil
+}
+
+// HandleRecent handles the recent tickets view
+func (m Model) HandleRecent(msg tea.KeyMsg) (Model, tea.Cmd) {
+	newModel := m
+	count := len(m.recent)
+
+	switch {
+	case isForceQuit(msg):
+		return newModel, tea.Quit
+	case key.Matches(msg, m.keys.Back, m.keys.Recent):
+		newModel.SetViewMode(ViewList)
+		return newModel, nil
+	case key.Matches(msg, m.keys.Help):
+		return newModel.showHelp()
+	case key.Matches(msg, m.keys.Up):
+		if count > 0 {
+			newModel.recentIndex = (newModel.recentIndex - 1 + count) % count
+		}
+		return newModel, nil
+	case key.Matches(msg, m.keys.Down):
+		if count > 0 {
+			newModel.recentIndex = (newModel.recentIndex + 1) % count
+		}
+		return newModel, nil
+	}
+	if count == 0 {
+		return newModel, nil
+	}
+
+	ticket := m.recent[m.recentIndex]
+	if key.Matches(msg, m.keys.Choose) {
+		// Jump to the ticket in the list, dropping a filter that hides it
+		newModel.SetViewMode(ViewList)
+		newModel.selectTicket(ticket.ID)
+		if selected, ok := newModel.list.SelectedItem().(storage.Ticket); !ok || selected.ID != ticket.ID {
+			newModel.RefreshList()
+			newModel.selectTicket(ticket.ID)
+		}
+		return newModel, nil
+	}
+	switch msg.String() {
+	case "o":
+		var cmd tea.Cmd
+		newModel, cmd = newModel.openTicket(ticket)
+		newModel.recent = newModel.storage.RecentTickets(maxRecentTickets)
+		newModel.recentIndex = 0
+		return newModel, cmd
+	case "c":
+		var cmd tea.Cmd
+		newModel, cmd = newModel.copyTicket(ticket)
+		newModel.recent = newModel.storage.RecentTickets(maxRecentTickets)
+		newModel.recentIndex = 0
+		return newModel, cmd
+	case "v":
+		return newModel.showDetail(ticket.ID)
+	}
+	return newModel, nil
+}
+
+// showDetail opens the detail view for a ticket, returning to the current view afterwards
+func (m Model) showDetail(id int) (Model, tea.Cmd) {
+	newModel := m
+	newModel.detailID = id
+	newModel.detailReturn = m.viewMode
+	newModel.SetViewMode(ViewDetail)
+	return newModel, nil
+}
+
+// detailTicket returns the ticket shown in the detail view
+func (m Model) detailTicket() (storage.Ticket, bool) {
+	tickets := m.storage.TicketsByID([]int{m.detailID})
+	if len(tickets) == 0 {
+		return storage.Ticket{}, false
+	}
+	return tickets[0], true
+}
+
+// HandleDetail handles the ticket detail view
+func (m Model) HandleDetail(msg tea.KeyMsg) (Model, tea.Cmd) {
+	newModel := m
+
+	switch {
+	case isForceQuit(msg):
+		return newModel, tea.Quit
+	case key.Matches(msg, m.keys.Back, m.keys.Details):
+		newModel.SetViewMode(m.detailReturn)
+		return newModel, nil
+	case key.Matches(msg, m.keys.Help):
+		return newModel.showHelp()
+	}
+
+	ticket, ok := m.detailTicket()
+	if !ok {
+		newModel.SetViewMode(ViewList)
+		return newModel, newModel.notifyWarning(i18n.T("ticket.already_deleted"))
+	}
+	switch msg.String() {
+	case "o":
+		return newModel.openTicket(ticket)
+	case "enter", "c":
+		return newModel.copyTicket(ticket)
+	}
+	return newModel, nil
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+	"gotickets/internal/watch"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TicketsChangedMsg is sent when tickets.json changes on disk
+type TicketsChangedMsg struct{}
+
+// WatchTickets starts watching tickets.json for changes made by scripts or
+// another instance; Init then waits for the first change
+func (m *Model) WatchTickets() {
+	path, err := storage.TicketsFile(m.storage.FileSystem())
+	if err != nil {
+		return
+	}
+	m.watcher = watch.New(path)
+}
+
+// StopWatching stops the file watcher started by WatchTickets
+func (m Model) StopWatching() {
+	if m.watcher != nil {
+		m.watcher.Close()
+	}
+}
+
+// waitForTicketsChange returns the command that blocks until the next change
+func (m Model) waitForTicketsChange() tea.Cmd {
+	if m.watcher == nil {
+		return nil
+	}
+	changes := m.watcher.Changes()
+	return func() tea.Msg {
+		if _, ok := <-changes; !ok {
+			return nil
+		}
+		return TicketsChangedMsg{}
+	}
+}
+
+// HandleTicketsChanged reloads the tickets after tickets.json changed on disk.
+// Our own saves leave the file equal to the tickets in memory and are ignored.
+// With unsaved changes in memory the user decides which version to keep.
+func (m Model) HandleTicketsChanged(msg TicketsChangedMsg) (Model, tea.Cmd) {
+	newModel := m
+	wait := m.waitForTicketsChange()
+	changed, err := m.storage.LoadChanged()
+	if err != nil {
+		return newModel, tea.Batch(wait, newModel.notifyWarning(i18n.T("reload.check_failed", err)))
+	}
+	if changed == nil || m.viewMode == ViewConfirmReload {
+		return newModel, wait
+	}
+	if m.dirty {
+		newModel.reloadReturn = m.viewMode
+		newModel.SetViewMode(ViewConfirmReload)
+		return newModel, wait
+	}
+	newModel, cmd := newModel.reloadTickets()
+	return newModel, tea.Batch(wait, cmd)
+}
+
+// reloadTickets replaces the tickets in memory with tickets.json, keeping the
+// current filter and the ticket under the cursor. A file that cannot be read
+// as tickets leaves the tickets in memory alone.
+func (m Model) reloadTickets() (Model, tea.Cmd) {
+	newModel := m
+	ticketStorage, err := m.storage.LoadChanged()
+	if err != nil {
+		return newModel, newModel.notifyWarning(i18n.T("reload.check_failed", err))
+	}
+	if ticketStorage == nil {
+		// The file was changed back to the tickets in memory
+		return newModel, nil
+	}
+	ticketStorage.SetURLPolicy(m.config.URLPolicy())
+	newModel.SetStorage(ticketStorage)
+	newModel.dirty = false
+	newModel.reapplyFilter()
+	return newModel, newModel.notifyInfo(i18n.T("reload.done"))
+}
+
+// HandleConfirmReload asks whether to load tickets.json over unsaved changes
+func (m Model) HandleConfirmReload(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case isForceQuit(msg):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Confirm):
+		return m.answerReload(true)
+	case key.Matches(msg, m.keys.Deny):
+		return m.answerReload(false)
+	}
+	return m, nil
+}
+
+// answerReload loads the file if confirmed; otherwise the changes in memory
+// are kept and replace the file on the next save
+func (m Model) answerReload(confirmed bool) (Model, tea.Cmd) {
+	newModel := m
+	newModel.SetViewMode(m.reloadReturn)
+	if confirmed {
+		return newModel.reloadTickets()
+	}
+	return newModel, newModel.notifyWarning(i18n.T("reload.kept", m.keys.Save.Help().Key))
+}
+
+// confirmReloadDialog returns the text and the answers of the reload prompt
+func (m Model) confirmReloadDialog() (string, []string) {
+	body := m.styles.Header.Render(i18n.T("view.reload.header")) + "\n\n" +
+		i18n.T("view.reload.question") + "\n\n" +
+		"⚠️  " + i18n.T("view.reload.warning") + "\n"
+	return body, []string{m.keys.Confirm.Help().Key, i18n.T("help.reload"), m.keys.Deny.Help().Key, i18n.T("help.keep_mine")}
+}
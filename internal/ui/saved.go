@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"strings"
+
+	"gotickets/internal/i18n"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxQuickFilters is the number of saved searches reachable with the keys 1-9
+const maxQuickFilters = 9
+
+// quickFilterIndex returns the saved search index for the keys 1-9
+func quickFilterIndex(key string) (int, bool) {
+	if len(key) != 1 || key[0] < '1' || key[0] > '0'+maxQuickFilters {
+		return 0, false
+	}
+	return int(key[0] - '1'), true
+}
+
+// applySavedSearch filters the list by a saved search and shows its name in the title
+func (m Model) applySavedSearch(index int) (Model, tea.Cmd) {
+	newModel := m
+	if index < 0 || index >= len(m.storage.SavedSearches) {
+		return newModel, newModel.notifyWarning(i18n.T("saved.missing", index+1, newModel.keys.Filters.Help().Key))
+	}
+	saved := m.storage.SavedSearches[index]
+	newModel.SetViewMode(ViewList)
+	newModel.searchQuery = saved.Query
+	newModel.activeSearch = saved.Name
+	newModel.FilterList(saved.Query)
+	if newModel.searchError != "" {
+		err := newModel.searchError
+		newModel.searchError = ""
+		newModel.activeSearch = ""
+		return newModel, newModel.notifyError(i18n.T("saved.apply_failed", saved.Name, err))
+	}
+	return newModel, nil
+}
+
+func (m Model) handleSavedSearches() (Model, tea.Cmd) {
+	newModel := m
+	newModel.selectedSavedIndex = 0
+	newModel.SetViewMode(ViewSavedSearches)
+	return newModel, nil
+}
+
+// handleSaveSearch asks for a name for the current search query
+func (m Model) handleSaveSearch() (Model, tea.Cmd) {
+	newModel := m
+	if strings.TrimSpace(m.searchQuery) == "" {
+		return newModel, newModel.notifyWarning(i18n.T("saved.no_search", newModel.keys.Search.Help().Key))
+	}
+	newModel.SetViewMode(ViewSaveSearch)
+	newModel.textInput.SetValue(m.activeSearch)
+	newModel.textInput.Placeholder = i18n.T("saved.placeholder")
+	newModel.textInput.Focus()
+	return newModel, nil
+}
+
+// HandleSavedSearches handles the saved search picker
+func (m Model) HandleSavedSearches(msg tea.KeyMsg) (Model, tea.Cmd) {
+	newModel := m
+	count := len(m.storage.SavedSearches)
+
+	switch {
+	case isForceQuit(msg):
+		return newModel, tea.Quit
+	case key.Matches(msg, m.keys.Back):
+		newModel.SetViewMode(ViewList)
+		return newModel, nil
+	case key.Matches(msg, m.keys.Help):
+		return newModel.showHelp()
+	case key.Matches(msg, m.keys.Up):
+		if count > 0 {
+			newModel.selectedSavedIndex = (newModel.selectedSavedIndex - 1 + count) % count
+		}
+		return newModel, nil
+	case key.Matches(msg, m.keys.Down):
+		if count > 0 {
+			newModel.selectedSavedIndex = (newModel.selectedSavedIndex + 1) % count
+		}
+		return newModel, nil
+	case key.Matches(msg, m.keys.Choose):
+		if count > 0 {
+			return newModel.applySavedSearch(newModel.selectedSavedIndex)
+		}
+		return newModel, nil
+	case msg.String() == "d":
+		if count == 0 {
+			return newModel, nil
+		}
+		saved := newModel.storage.SavedSearches[newModel.selectedSavedIndex]
+		if err := newModel.storage.DeleteSavedSearch(saved.Name); err != nil {
+			return newModel, newModel.notifyError(err.Error())
+		}
+		if newModel.activeSearch == saved.Name {
+			newModel.activeSearch = ""
+		}
+		if newModel.selectedSavedIndex >= len(newModel.storage.SavedSearches) && newModel.selectedSavedIndex > 0 {
+			newModel.selectedSavedIndex--
+		}
+		return newModel, tea.Batch(newModel.saveStorage(), newModel.notifyInfo(i18n.T("saved.deleted", saved.Name)))
+	}
+
+	if index, ok := quickFilterIndex(msg.String()); ok {
+		return newModel.applySavedSearch(index)
+	}
+	return newModel, nil
+}
+
+// HandleSaveSearch handles the name input for saving the current search
+func (m Model) HandleSaveSearch(msg tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	newModel := m
+
+	switch msg.String() {
+	case "ctrl+c":
+		return newModel, tea.Quit
+	case "esc":
+		newModel.SetViewMode(ViewList)
+		newModel.ClearTextInput()
+		return newModel, nil
+	case "enter":
+		name := strings.TrimSpace(newModel.textInput.Value())
+		if err := newModel.storage.SaveSearch(name, newModel.searchQuery); err != nil {
+			return newModel, newModel.notifyError(i18n.T("saved.save_failed", err))
+		}
+		newModel.SetViewMode(ViewList)
+		newModel.ClearTextInput()
+		newModel.activeSearch = name
+		newModel.FilterList(newModel.searchQuery)
+		return newModel, tea.Batch(newModel.saveStorage(), newModel.notifyInfo(i18n.T("saved.saved", name)))
+	}
+
+	newModel.textInput, cmd = newModel.textInput.Update(msg)
+	return newModel, cmd
+}
@@ -13,10 +13,16 @@ func (m Model) HandleSearch(msg tea.KeyMsg) (Model, tea.Cmd) {
 	case "esc":
 		newModel.searchMode = false
 		newModel.searchQuery = ""
+		newModel.searchError = ""
 		newModel.ClearTextInput()
 		newModel.RefreshList() // Reset to show all tickets
 		return newModel, nil
 	case "enter":
+		// Stay in search mode until the query parses
+		newModel.FilterList(newModel.textInput.Value())
+		if newModel.searchError != "" {
+			return newModel, nil
+		}
 		// Apply current search and exit search mode
 		newModel.searchMode = false
 		newModel.searchQuery = newModel.textInput.Value()
@@ -35,6 +41,10 @@ func (m Model) HandleSearch(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 	// Filter tickets in real time as user types
 	query := newModel.textInput.Value()
+	if query != newModel.searchQuery {
+		// An edited query is no longer the saved filter
+		newModel.activeSearch = ""
+	}
 	newModel.FilterList(query)
 
 	return newModel, cmd
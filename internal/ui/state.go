@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"sort"
+
+	"gotickets/internal/storage"
+)
+
+// State returns the UI state worth restoring on the next start
+func (m Model) State() *storage.UIState {
+	state := &storage.UIState{Query: m.searchQuery, SavedSearch: m.activeSearch}
+	if m.sortMode != storage.SortAdded {
+		state.Sort = string(m.sortMode)
+	}
+	state.Group = string(m.groupMode)
+	for name, collapsed := range m.collapsedGroups {
+		if collapsed {
+			state.Collapsed = append(state.Collapsed, name)
+		}
+	}
+	sort.Strings(state.Collapsed)
+	if ticket, ok := m.list.SelectedItem().(storage.Ticket); ok {
+		state.SelectedID = ticket.ID
+	}
+	return state
+}
+
+// SaveState writes the UI state next to the tickets
+func (m Model) SaveState() error {
+	return storage.SaveState(m.storage.FileSystem(), m.State())
+}
+
+// restoreState reapplies the sort, grouping, search and selection of a previous run.
+// A query that no longer parses and an unknown sort mode are dropped.
+func (m *Model) restoreState(state *storage.UIState) {
+	if mode, err := storage.ParseSortMode(state.Sort); err == nil {
+		m.sortMode = mode
+	}
+	m.groupMode = storage.ParseGroupMode(state.Group)
+	if len(state.Collapsed) > 0 {
+		m.collapsedGroups = make(map[string]bool, len(state.Collapsed))
+		for _, name := range state.Collapsed {
+			m.collapsedGroups[name] = true
+		}
+	}
+	m.RefreshList()
+	if state.Query != "" {
+		if saved, ok := m.storage.FindSavedSearch(state.SavedSearch); ok && saved.Query == state.Query {
+			m.activeSearch = saved.Name
+		}
+		m.FilterList(state.Query)
+		if m.searchError != "" {
+			m.searchError = ""
+			m.RefreshList()
+		} else {
+			m.searchQuery = state.Query
+		}
+	}
+	if state.SelectedID != 0 {
+		m.selectTicket(state.SelectedID)
+	}
+}
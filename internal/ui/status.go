@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"gotickets/internal/i18n"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// toastLevel is the severity of a status notification
+type toastLevel int
+
+const (
+	toastInfo toastLevel = iota
+	toastWarning
+	toastError
+)
+
+// maxVisibleToasts limits how many notifications are stacked in the status area
+const maxVisibleToasts = 3
+
+// toast is a notification shown in the status area until it expires
+type toast struct {
+	id    int
+	level toastLevel
+	text  string
+}
+
+// ToastExpiredMsg is sent when a notification's lifetime is over
+type ToastExpiredMsg struct {
+	ID int
+}
+
+func (l toastLevel) lifetime() time.Duration {
+	switch l {
+	case toastError:
+		return 8 * time.Second
+	case toastWarning:
+		return 5 * time.Second
+	default:
+		return 3 * time.Second
+	}
+}
+
+// notify adds a notification and returns the command that expires it
+func (m *Model) notify(level toastLevel, text string) tea.Cmd {
+	m.nextToastID++
+	id := m.nextToastID
+	m.toasts = append(m.toasts, toast{id: id, level: level, text: text})
+	return tea.Tick(level.lifetime(), func(time.Time) tea.Msg {
+		return ToastExpiredMsg{ID: id}
+	})
+}
+
+func (m *Model) notifyInfo(text string) tea.Cmd    { return m.notify(toastInfo, text) }
+func (m *Model) notifyWarning(text string) tea.Cmd { return m.notify(toastWarning, text) }
+func (m *Model) notifyError(text string) tea.Cmd   { return m.notify(toastError, text) }
+
+// HandleToastExpired removes an expired notification
+func (m Model) HandleToastExpired(msg ToastExpiredMsg) Model {
+	newModel := m
+	toasts := make([]toast, 0, len(m.toasts))
+	for _, t := range m.toasts {
+		if t.id != msg.ID {
+			toasts = append(toasts, t)
+		}
+	}
+	newModel.toasts = toasts
+	return newModel
+}
+
+// saveStorage persists the tickets. On failure the changes stay in memory,
+// the model is marked dirty and the save can be retried with ctrl+s.
+func (m *Model) saveStorage() tea.Cmd {
+	if err := m.storage.Save(); err != nil {
+		m.dirty = true
+		return m.notifyError(i18n.T("status.save_failed", err, m.keys.Save.Help().Key))
+	}
+	m.dirty = false
+	return nil
+}
+
+// handleRetrySave retries a previously failed save
+func (m Model) handleRetrySave() (Model, tea.Cmd) {
+	newModel := m
+	if !newModel.dirty {
+		return newModel, newModel.notifyInfo(i18n.T("status.nothing_to_save"))
+	}
+	if cmd := newModel.saveStorage(); cmd != nil {
+		return newModel, cmd
+	}
+	return newModel, newModel.notifyInfo(i18n.T("status.saved"))
+}
+
+// handleQuit quits unless there are changes that could not be saved
+func (m Model) handleQuit() (Model, tea.Cmd) {
+	if !m.dirty {
+		return m, tea.Quit
+	}
+	newModel := m
+	if cmd := newModel.saveStorage(); cmd != nil {
+		return newModel, tea.Batch(cmd, newModel.notifyWarning(i18n.T("status.quit_unsaved", newModel.keys.Save.Help().Key, forceQuitKey)))
+	}
+	return newModel, tea.Quit
+}
+
+func (m Model) renderStatus() string {
+	if len(m.toasts) == 0 && !m.dirty {
+		return ""
+	}
+	var lines []string
+	if m.dirty {
+		lines = append(lines, m.styles.Warning.Render("● "+i18n.T("status.dirty", m.keys.Save.Help().Key)))
+	}
+	toasts := m.toasts
+	if len(toasts) > maxVisibleToasts {
+		toasts = toasts[len(toasts)-maxVisibleToasts:]
+	}
+	for _, t := range toasts {
+		switch t.level {
+		case toastError:
+			lines = append(lines, m.styles.Error.Render("❌ "+t.text))
+		case toastWarning:
+			lines = append(lines, m.styles.Warning.Render("⚠️  "+t.text))
+		default:
+			lines = append(lines, m.styles.Info.Render("ℹ️  "+t.text))
+		}
+	}
+	return lipgloss.NewStyle().MarginTop(1).Render(strings.Join(lines, "\n"))
+}
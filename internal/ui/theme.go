@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gotickets/internal/i18n"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is a named color palette. A theme without colors relies on text
+// attributes (bold, underline, reverse) only.
+type Theme struct {
+	Name    string
+	NoColor bool
+
+	Accent     lipgloss.TerminalColor // headers and group names
+	Border     lipgloss.TerminalColor // input and title borders
+	Muted      lipgloss.TerminalColor // help box border
+	SelectedFg lipgloss.TerminalColor // selected row text
+	SelectedBg lipgloss.TerminalColor // selected row background
+	Highlight  lipgloss.TerminalColor // search matches and pins
+	Marked     lipgloss.TerminalColor // tickets picked for bulk actions
+	Error      lipgloss.TerminalColor
+	Warning    lipgloss.TerminalColor
+	Info       lipgloss.TerminalColor
+	Key        lipgloss.TerminalColor // keys in help lines
+	Action     lipgloss.TerminalColor // actions in help lines
+}
+
+// NoColorTheme is used for "no-color" and whenever NO_COLOR is set
+const NoColorTheme = "no-color"
+
+// DefaultTheme is used when the config does not name a theme
+const DefaultTheme = "dark"
+
+// Themes lists the built-in themes by name
+var Themes = map[string]Theme{
+	"dark": {
+		Name:       "dark",
+		Accent:     lipgloss.Color("86"),
+		Border:     lipgloss.Color("62"),
+		Muted:      lipgloss.Color("240"),
+		SelectedFg: lipgloss.Color("0"),
+		SelectedBg: lipgloss.Color("12"),
+		Highlight:  lipgloss.Color("11"),
+		Marked:     lipgloss.Color("10"),
+		Error:      lipgloss.Color("9"),
+		Warning:    lipgloss.Color("11"),
+		Info:       lipgloss.Color("10"),
+		Key:        lipgloss.Color("12"),
+		Action:     lipgloss.Color("250"),
+	},
+	"light": {
+		Name:       "light",
+		Accent:     lipgloss.Color("25"),
+		Border:     lipgloss.Color("61"),
+		Muted:      lipgloss.Color("248"),
+		SelectedFg: lipgloss.Color("15"),
+		SelectedBg: lipgloss.Color("25"),
+		Highlight:  lipgloss.Color("130"),
+		Marked:     lipgloss.Color("28"),
+		Error:      lipgloss.Color("160"),
+		Warning:    lipgloss.Color("130"),
+		Info:       lipgloss.Color("28"),
+		Key:        lipgloss.Color("25"),
+		Action:     lipgloss.Color("238"),
+	},
+	"high-contrast": {
+		Name:       "high-contrast",
+		Accent:     lipgloss.Color("15"),
+		Border:     lipgloss.Color("15"),
+		Muted:      lipgloss.Color("15"),
+		SelectedFg: lipgloss.Color("0"),
+		SelectedBg: lipgloss.Color("11"),
+		Highlight:  lipgloss.Color("14"),
+		Marked:     lipgloss.Color("10"),
+		Error:      lipgloss.Color("9"),
+		Warning:    lipgloss.Color("11"),
+		Info:       lipgloss.Color("10"),
+		Key:        lipgloss.Color("14"),
+		Action:     lipgloss.Color("15"),
+	},
+	NoColorTheme: {
+		Name:       NoColorTheme,
+		NoColor:    true,
+		Accent:     lipgloss.NoColor{},
+		Border:     lipgloss.NoColor{},
+		Muted:      lipgloss.NoColor{},
+		SelectedFg: lipgloss.NoColor{},
+		SelectedBg: lipgloss.NoColor{},
+		Highlight:  lipgloss.NoColor{},
+		Marked:     lipgloss.NoColor{},
+		Error:      lipgloss.NoColor{},
+		Warning:    lipgloss.NoColor{},
+		Info:       lipgloss.NoColor{},
+		Key:        lipgloss.NoColor{},
+		Action:     lipgloss.NoColor{},
+	},
+}
+
+// ThemeNames returns the names of the built-in themes in alphabetical order
+func ThemeNames() []string {
+	names := make([]string, 0, len(Themes))
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveTheme picks the theme by name (empty means DefaultTheme). noColor,
+// set from the NO_COLOR environment variable, always selects the no-color theme.
+// An unknown name falls back to the default theme with an error.
+func ResolveTheme(name string, noColor bool) (Theme, error) {
+	if noColor {
+		return Themes[NoColorTheme], nil
+	}
+	if name == "" {
+		return Themes[DefaultTheme], nil
+	}
+	theme, ok := Themes[strings.ToLower(name)]
+	if !ok {
+		return Themes[DefaultTheme], fmt.Errorf(i18n.T("theme.unknown"), name, strings.Join(ThemeNames(), ", "))
+	}
+	return theme, nil
+}
+
+// envNoColor reports whether NO_COLOR is set (https://no-color.org)
+func envNoColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// Styles holds every style used by the renderers; it is built once from a theme
+type Styles struct {
+	Header  lipgloss.Style
+	Input   lipgloss.Style
+	Error   lipgloss.Style
+	Warning lipgloss.Style
+	Info    lipgloss.Style
+	Key     lipgloss.Style
+	Action  lipgloss.Style
+	Help    lipgloss.Style
+
+	// Title is the border around the list title
+	Title lipgloss.Style
+	// Selected is the highlighted row of lists and pickers
+	Selected lipgloss.Style
+	// Match styles the matched characters of a search result
+	Match lipgloss.Style
+	// Pin is the marker of pinned tickets
+	Pin lipgloss.Style
+	// Marked is the marker of tickets picked for a bulk action
+	Marked lipgloss.Style
+	// GroupHeader is a group name in the grouped list
+	GroupHeader lipgloss.Style
+}
+
+// NewStyles builds the styles of a theme
+func NewStyles(theme Theme) Styles {
+	s := Styles{
+		Header:      lipgloss.NewStyle().Foreground(theme.Accent).Bold(true).Padding(1, 2),
+		Input:       lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(theme.Border).Padding(0, 1),
+		Error:       lipgloss.NewStyle().Foreground(theme.Error).Bold(true),
+		Warning:     lipgloss.NewStyle().Foreground(theme.Warning).Bold(true),
+		Info:        lipgloss.NewStyle().Foreground(theme.Info),
+		Key:         lipgloss.NewStyle().Foreground(theme.Key).Bold(true),
+		Action:      lipgloss.NewStyle().Foreground(theme.Action),
+		Help:        lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(theme.Muted).Padding(0, 1).MarginTop(1),
+		Title:       lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(theme.Border).Padding(0, 1).Align(lipgloss.Center),
+		Selected:    lipgloss.NewStyle().Foreground(theme.SelectedFg).Background(theme.SelectedBg),
+		Match:       lipgloss.NewStyle().Foreground(theme.Highlight).Bold(true).Underline(true),
+		Pin:         lipgloss.NewStyle().Foreground(theme.Highlight),
+		Marked:      lipgloss.NewStyle().Foreground(theme.Marked).Bold(true),
+		GroupHeader: lipgloss.NewStyle().Foreground(theme.Accent).Bold(true),
+	}
+	if theme.NoColor {
+		// Without colors the selection is shown in reverse video
+		s.Selected = s.Selected.Reverse(true)
+	}
+	return s
+}
+
+// renderSelected renders the highlighted row of a picker
+func (s Styles) renderSelected(line string) string {
+	return s.Selected.Padding(0, 1).Render("> " + line)
+}
+
+// handleSetTheme switches the theme for the rest of the session
+func (m Model) handleSetTheme(name string) (Model, tea.Cmd) {
+	newModel := m
+	theme, err := ResolveTheme(name, envNoColor())
+	if err != nil {
+		return newModel, newModel.notifyWarning(err.Error())
+	}
+	newModel.styles = NewStyles(theme)
+	newModel.list.Styles.Title = newModel.styles.Title
+	newModel.applyDelegate()
+	styleBackupList(&newModel.backupList, newModel.styles)
+	if theme.Name != name {
+		// NO_COLOR is set and keeps the no-color theme
+		return newModel, newModel.notifyWarning(i18n.T("theme.no_color"))
+	}
+	return newModel, newModel.notifyInfo(i18n.T("theme.set", theme.Name))
+}
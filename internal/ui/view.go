@@ -3,12 +3,23 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
 )
 
-// View renders the current view based on the view mode
+// View renders the current view followed by the status area
 func (m Model) View() string {
+	if status := m.renderStatus(); status != "" {
+		return m.renderView() + "\n" + status
+	}
+	return m.renderView()
+}
+
+// renderView renders the current view based on the view mode
+func (m Model) renderView() string {
 	switch m.viewMode {
 	case ViewList:
 		return m.renderListView()
@@ -17,7 +28,7 @@ func (m Model) View() string {
 	case ViewAddTitle:
 		return m.renderAddTitleView()
 	case ViewConfirmDelete:
-		return m.renderConfirmDeleteView()
+		return m.renderDialog(m.confirmDeleteDialog())
 	case ViewImport:
 		return m.renderImportView()
 	case ViewImportResult:
@@ -25,7 +36,33 @@ func (m Model) View() string {
 	case ViewBackups:
 		return m.renderBackupsView()
 	case ViewConfirmRestore:
-		return m.renderConfirmRestoreView()
+		return m.renderDialog(m.confirmRestoreDialog())
+	case ViewImportPreview:
+		return m.renderImportPreviewView()
+	case ViewSavedSearches:
+		return m.renderSavedSearchesView()
+	case ViewSaveSearch:
+		return m.renderSaveSearchView()
+	case ViewBulkActions:
+		return m.renderBulkActionsView()
+	case ViewBulkInput:
+		return m.renderBulkInputView()
+	case ViewConfirmBulk:
+		return m.renderDialog(m.confirmBulkDialog())
+	case ViewRecent:
+		return m.renderRecentView()
+	case ViewDetail:
+		return m.renderDetailView()
+	case ViewHelp:
+		return m.renderHelpView()
+	case ViewPalette:
+		return m.renderPaletteView()
+	case ViewConfirmReload:
+		return m.renderDialog(m.confirmReloadDialog())
+	case ViewBackupInput:
+		return m.renderBackupInputView()
+	case ViewConfirmBackupDelete:
+		return m.renderDialog(m.confirmBackupDeleteDialog())
 	default:
 		return "Unknown view mode"
 	}
@@ -37,47 +74,64 @@ func (m Model) renderListView() string {
 		var s strings.Builder
 		s.WriteString(m.list.View())
 		s.WriteString("\n")
-		s.WriteString(m.getInputStyle().Render("Поиск: " + m.textInput.View()))
+		s.WriteString(m.styles.Input.Render(i18n.T("view.search.prompt") + " " + m.textInput.View()))
 		s.WriteString("\n")
-		s.WriteString(m.formatKeyHelp("Enter", "применить поиск", "Esc", "отмена"))
+		if m.searchError != "" {
+			s.WriteString(m.styles.Error.Render("❌ " + m.searchError))
+			s.WriteString("\n")
+		}
+		s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.apply_search"), "Esc", i18n.T("help.cancel")))
 		return s.String()
 	}
+	if count := len(m.selectedIDs()); count > 0 {
+		return m.list.View() + "\n" + m.styles.Warning.Render(i18n.T("view.selected", count)) + "  " +
+			m.formatKeyHelp(m.keys.Bulk.Help().Key, i18n.T("help.actions"), m.keys.SelectAll.Help().Key, i18n.T("help.select_all"), m.keys.ClearSelection.Help().Key, i18n.T("help.clear_selection"))
+	}
 	return m.list.View()
 }
 
 func (m Model) renderAddURLView() string {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Добавить новый тикет - Ссылка"))
+	s.WriteString(m.styles.Header.Render(i18n.T("view.add.url.header")))
 	s.WriteString("\n\n")
-	s.WriteString("Введите ссылку:\n")
-	s.WriteString(m.getInputStyle().Render(m.textInput.View()))
+	s.WriteString(i18n.T("view.add.url.prompt") + "\n")
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
 	s.WriteString("\n")
 
 	// Show error message if there is one
 	if m.urlError != "" {
-		s.WriteString(m.getErrorStyle().Render("❌ " + m.urlError))
+		s.WriteString(m.styles.Error.Render("❌ " + m.urlError))
 		s.WriteString("\n")
 	}
 
-	s.WriteString(m.formatKeyHelp("Enter", "продолжить к названию", "Esc", "отмена"))
+	s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.continue_title"), "Esc", i18n.T("help.cancel")))
 	return s.String()
 }
 
 func (m Model) renderAddTitleView() string {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Добавить новый тикет - Название"))
+	s.WriteString(m.styles.Header.Render(i18n.T("view.add.title.header")))
 	s.WriteString("\n\n")
-	s.WriteString(fmt.Sprintf("Ссылка: %s\n", m.tempURL))
-	s.WriteString("Введите название тикета:\n")
-	s.WriteString(m.getInputStyle().Render(m.textInput.View()))
+	s.WriteString(i18n.T("view.add.title.link", m.tempResolved.URL) + "\n")
+	if m.tempResolved.Key != "" {
+		s.WriteString(i18n.T("view.add.title.key", m.tempResolved.Key) + "\n")
+	}
+	s.WriteString(i18n.T("view.add.title.prompt") + "\n")
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
 	s.WriteString("\n")
-	s.WriteString(m.formatKeyHelp("Enter", "сохранить тикет", "Esc", "отмена"))
+	s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.save_ticket"), "Esc", i18n.T("help.cancel")))
 	return s.String()
 }
 
-func (m Model) renderConfirmDeleteView() string {
+// renderDialog renders a confirmation dialog: its text followed by the answers
+func (m Model) renderDialog(body string, answers []string) string {
+	return body + m.formatKeyHelp(answers...)
+}
+
+// confirmDeleteDialog returns the text and the answers of the delete confirmation
+func (m Model) confirmDeleteDialog() (string, []string) {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Подтверждение удаления"))
+	s.WriteString(m.styles.Header.Render(i18n.T("view.delete.header")))
 	s.WriteString("\n\n")
 
 	// Find the ticket to delete
@@ -89,36 +143,44 @@ func (m Model) renderConfirmDeleteView() string {
 		}
 	}
 
-	s.WriteString(fmt.Sprintf("Вы уверены, что хотите удалить тикет?\n"))
-	s.WriteString(fmt.Sprintf("Тикет: #%d - %s\n", m.ticketToDelete, ticketTitle))
-	s.WriteString(m.formatKeyHelp("y/Enter", "да, удалить", "n/Esc", "нет, отменить"))
-	return s.String()
+	s.WriteString(i18n.T("view.delete.question") + "\n")
+	s.WriteString(i18n.T("view.delete.ticket", m.ticketToDelete, ticketTitle) + "\n")
+	return s.String(), []string{m.keys.Confirm.Help().Key, i18n.T("help.yes_delete"), m.keys.Deny.Help().Key, i18n.T("help.no_cancel")}
 }
 
 func (m Model) renderImportView() string {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Импорт тикетов"))
+	s.WriteString(m.styles.Header.Render(i18n.T("view.import.header")))
 	s.WriteString("\n\n")
-	s.WriteString("Введите путь к .txt файлу:\n")
-	s.WriteString("Формат файла: каждая строка содержит 'URL - Название'\n\n")
-	s.WriteString(m.getInputStyle().Render(m.textInput.View()))
+	s.WriteString(i18n.T("view.import.path") + "\n")
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
+	s.WriteString("\n")
+	s.WriteString(i18n.T("view.import.format") + "\n")
+	s.WriteString(m.styles.Input.Render(m.formatInput.View()))
 	s.WriteString("\n")
-	s.WriteString(m.formatKeyHelp("Enter", "начать импорт", "Esc", "отмена"))
+	if m.importFormatError != "" {
+		s.WriteString(m.styles.Error.Render("❌ " + m.importFormatError))
+		s.WriteString("\n")
+	}
+	s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.start_import"), "Tab", i18n.T("help.path_format"), "Esc", i18n.T("help.cancel")))
 	return s.String()
 }
 
 func (m Model) renderImportResultView() string {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Результат импорта"))
+	s.WriteString(m.styles.Header.Render(i18n.T("view.import.result.header")))
 	s.WriteString("\n\n")
 
 	if m.importResult != nil {
-		s.WriteString(fmt.Sprintf("✅ Добавлено тикетов: %d\n", m.importResult.Added))
-		s.WriteString(fmt.Sprintf("🔄 Дубликатов пропущено: %d\n", m.importResult.Duplicates))
-		s.WriteString(fmt.Sprintf("❌ Ошибок формата: %d\n", m.importResult.Errors))
+		s.WriteString("✅ " + i18n.T("import.result.added", m.importResult.Added) + "\n")
+		s.WriteString("🔄 " + i18n.T("import.result.duplicates", m.importResult.Duplicates) + "\n")
+		s.WriteString("❌ " + i18n.T("import.result.errors", m.importResult.Errors) + "\n")
+		if m.importResult.Skipped > 0 {
+			s.WriteString("⏭  " + i18n.T("import.result.skipped", m.importResult.Skipped) + "\n")
+		}
 
 		if len(m.importResult.ErrorLines) > 0 {
-			s.WriteString("\nОшибки:\n")
+			s.WriteString("\n" + i18n.T("view.import.result.errors") + "\n")
 			for _, errLine := range m.importResult.ErrorLines {
 				s.WriteString(fmt.Sprintf("  • %s\n", errLine))
 			}
@@ -126,88 +188,390 @@ func (m Model) renderImportResultView() string {
 	}
 
 	s.WriteString("\n")
-	s.WriteString(m.formatKeyHelp("Enter/Esc/Пробел", "вернуться к списку"))
+	s.WriteString(m.formatKeyHelp("Enter/Esc/"+i18n.T("key.space"), i18n.T("help.back_to_list")))
+	return s.String()
+}
+
+func (m Model) renderImportPreviewView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.preview.header")))
+	s.WriteString("\n\n")
+
+	if m.importPreview == nil || len(m.importPreview.Candidates) == 0 {
+		s.WriteString(i18n.T("view.preview.empty") + "\n")
+		s.WriteString(m.formatKeyHelp("Esc", i18n.T("help.back_to_list")))
+		return s.String()
+	}
+
+	summary := m.importPreview.Summary()
+	s.WriteString(i18n.T("view.preview.summary", summary.Added, summary.Duplicates, summary.Errors) + "\n\n")
+
+	// Only render a window of candidates around the cursor
+	candidates := m.importPreview.Candidates
+	window := m.list.Height()
+	if window < 5 {
+		window = 5
+	}
+	start := m.previewIndex - window/2
+	if start > len(candidates)-window {
+		start = len(candidates) - window
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + window
+	if end > len(candidates) {
+		end = len(candidates)
+	}
+
+	for i := start; i < end; i++ {
+		c := candidates[i]
+		var mark, text string
+		switch c.Status {
+		case storage.ImportInvalid:
+			mark = "✗"
+			text = i18n.T("view.preview.invalid", c.Line, c.Title, c.Error)
+		case storage.ImportDuplicate:
+			mark = "="
+			text = i18n.T("view.preview.duplicate", c.Line, c.URL, c.Title)
+		default:
+			mark = "[ ]"
+			if c.Selected {
+				mark = "[x]"
+			}
+			text = i18n.T("view.preview.new", c.Line, c.URL, c.Title)
+		}
+		if c.Format != "" && c.Status != storage.ImportInvalid {
+			text += fmt.Sprintf(" [%s]", c.Format)
+		}
+		line := mark + " " + text
+		switch {
+		case i == m.previewIndex:
+			s.WriteString(m.styles.renderSelected(line))
+		case c.Status == storage.ImportInvalid:
+			s.WriteString("  " + m.styles.Error.Render(line))
+		case c.Status == storage.ImportDuplicate:
+			s.WriteString("  " + m.styles.Action.Render(line))
+		default:
+			s.WriteString("  " + line)
+		}
+		s.WriteString("\n")
+	}
+
+	if m.previewEditing {
+		s.WriteString("\n" + i18n.T("view.preview.new_title") + "\n")
+		s.WriteString(m.styles.Input.Render(m.textInput.View()))
+		s.WriteString("\n")
+		s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.save_title"), "Esc", i18n.T("help.cancel")))
+		return s.String()
+	}
+
+	s.WriteString(m.formatKeyHelp(i18n.T("key.space"), i18n.T("help.mark"), "a", i18n.T("help.all_none"), "e", i18n.T("help.edit_title"), m.keys.Choose.Help().Key, i18n.T("help.import"), m.keys.Back.Help().Key, i18n.T("help.cancel")))
 	return s.String()
 }
 
+// backupsHeader renders everything above the first backup of the backup list:
+// the number of backups or the filter
+func (m Model) backupsHeader() string {
+	header := m.styles.Header.Render(i18n.T("view.backups.header")) + "\n\n"
+	total := len(m.backupList.Items())
+	switch {
+	case total == 0:
+		return header
+	case m.backupList.SettingFilter():
+		return header + m.backupList.FilterInput.View() + "\n\n"
+	case m.backupList.IsFiltered():
+		return header + i18n.T("view.backups.filtered", len(m.backupList.VisibleItems()), total, m.backupList.FilterValue()) + "\n\n"
+	}
+	return header + i18n.T("view.backups.count", total) + "\n\n"
+}
+
 func (m Model) renderBackupsView() string {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Резервные копии"))
+	s.WriteString(m.backupsHeader())
+
+	switch {
+	case len(m.backupList.Items()) == 0:
+		s.WriteString(i18n.T("view.backups.empty") + "\n")
+	case len(m.backupList.VisibleItems()) == 0:
+		s.WriteString(i18n.T("view.backups.no_match") + "\n")
+	default:
+		s.WriteString(m.backupList.View() + "\n")
+	}
+
+	if m.backupList.SettingFilter() {
+		s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.apply"), "Esc", i18n.T("help.cancel")))
+		return s.String()
+	}
+	s.WriteString(m.formatKeyHelp(
+		m.keys.Up.Help().Key+"/"+m.keys.Down.Help().Key, i18n.T("help.navigate"),
+		m.keys.Choose.Help().Key, i18n.T("help.restore"),
+		"d", i18n.T("help.delete"),
+		"e", i18n.T("help.export"),
+		"n", i18n.T("help.new_backup"),
+		m.keys.Search.Help().Key, i18n.T("help.filter"),
+		m.keys.Back.Help().Key, i18n.T("help.back"),
+	))
+	return s.String()
+}
+
+func (m Model) renderBackupInputView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.backups.header")))
+	s.WriteString("\n\n")
+	if m.backupInput == backupExport {
+		if backup, ok := m.selectedBackup(); ok {
+			s.WriteString(i18n.T("view.backups.export", backup.Name) + "\n")
+		}
+	} else {
+		s.WriteString(i18n.T("view.backups.label") + "\n")
+	}
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
+	s.WriteString("\n")
+	s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.continue"), "Esc", i18n.T("help.back")))
+	return s.String()
+}
+
+// confirmBackupDeleteDialog returns the text and the answers of the backup delete confirmation
+func (m Model) confirmBackupDeleteDialog() (string, []string) {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.backup_delete.header")))
+	s.WriteString("\n\n")
+	s.WriteString(i18n.T("view.backup_delete.question") + "\n")
+	s.WriteString(i18n.T("view.restore.backup", m.backupToDelete) + "\n\n")
+	s.WriteString("⚠️  " + i18n.T("view.backup_delete.warning") + "\n")
+	return s.String(), []string{m.keys.Confirm.Help().Key, i18n.T("help.yes_delete"), m.keys.Deny.Help().Key, i18n.T("help.no_cancel")}
+}
+
+func (m Model) renderSavedSearchesView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.saved.header")))
 	s.WriteString("\n\n")
 
-	if len(m.backups) == 0 {
-		s.WriteString("Резервные копии не найдены.\n")
+	if len(m.storage.SavedSearches) == 0 {
+		s.WriteString(i18n.T("view.saved.empty", m.keys.Search.Help().Key, m.keys.SaveFilter.Help().Key) + "\n")
 	} else {
-		s.WriteString(fmt.Sprintf("Найдено резервных копий: %d\n\n", len(m.backups)))
-		for i, backup := range m.backups {
-			if i == m.selectedBackupIndex {
-				// Highlight selected backup
-				s.WriteString(lipgloss.NewStyle().
-					Foreground(lipgloss.Color("0")).
-					Background(lipgloss.Color("12")).
-					Padding(0, 1).
-					Render(fmt.Sprintf("> %s", backup)))
+		for i, saved := range m.storage.SavedSearches {
+			shortcut := " "
+			if i < maxQuickFilters {
+				shortcut = fmt.Sprintf("%d", i+1)
+			}
+			line := fmt.Sprintf("%s  %s  %s", shortcut, saved.Name, m.styles.Help.Render(saved.Query))
+			if i == m.selectedSavedIndex {
+				s.WriteString(m.styles.renderSelected(fmt.Sprintf("%s  %s  %s", shortcut, saved.Name, saved.Query)))
 			} else {
-				s.WriteString(fmt.Sprintf("  %s", backup))
+				s.WriteString("  " + line)
 			}
 			s.WriteString("\n")
 		}
 	}
 
 	s.WriteString("\n")
-	s.WriteString(m.formatKeyHelp("↑/↓", "навигация", "Enter", "выбрать", "Esc", "отмена"))
+	s.WriteString(m.formatKeyHelp(m.keys.Up.Help().Key+"/"+m.keys.Down.Help().Key, i18n.T("help.navigate"), m.keys.Choose.Help().Key+"/1-9", i18n.T("help.apply"), "d", i18n.T("help.delete"), m.keys.Back.Help().Key, i18n.T("help.back")))
 	return s.String()
 }
 
-func (m Model) renderConfirmRestoreView() string {
+func (m Model) renderSaveSearchView() string {
 	var s strings.Builder
-	s.WriteString(m.getHeaderStyle().Render("Подтверждение восстановления"))
+	s.WriteString(m.styles.Header.Render(i18n.T("view.save_filter.header")))
 	s.WriteString("\n\n")
-	s.WriteString(fmt.Sprintf("Вы уверены, что хотите восстановить из резервной копии?\n"))
-	s.WriteString(fmt.Sprintf("Резервная копия: %s\n\n", m.backupToRestore))
-	s.WriteString("⚠️  ВНИМАНИЕ: Это заменит все текущие тикеты!\n")
-	s.WriteString(m.formatKeyHelp("y/Enter", "да, восстановить", "n/Esc", "нет, отменить"))
+	s.WriteString(i18n.T("view.save_filter.query", m.searchQuery) + "\n\n")
+	s.WriteString(i18n.T("view.save_filter.name") + "\n")
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
+	s.WriteString("\n")
+	s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.save"), "Esc", i18n.T("help.cancel")))
 	return s.String()
 }
 
-// Style helpers
-func (m Model) getHeaderStyle() lipgloss.Style {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("86")).
-		Bold(true).
-		Padding(1, 2)
+func (m Model) renderBulkActionsView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.bulk.header", len(m.selectedIDs()))))
+	s.WriteString("\n\n")
+	archive := i18n.T("view.bulk.archive")
+	if m.allSelectedArchived() {
+		archive = i18n.T("view.bulk.unarchive")
+	}
+	actions := []struct{ key, label string }{
+		{"d", i18n.T("help.delete")},
+		{"a", archive},
+		{"t", i18n.T("view.bulk.tag")},
+		{"s", i18n.T("view.bulk.status")},
+		{"e", i18n.T("view.bulk.export")},
+		{"c", i18n.T("view.bulk.copy")},
+		{"o", i18n.T("view.bulk.open")},
+	}
+	for _, action := range actions {
+		s.WriteString(fmt.Sprintf("  %s  %s\n", m.styles.Key.Render(action.key), action.label))
+	}
+	s.WriteString("\n")
+	s.WriteString(m.formatKeyHelp(m.keys.Back.Help().Key, i18n.T("help.back")))
+	return s.String()
 }
 
-func (m Model) getInputStyle() lipgloss.Style {
-	return lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("62")).
-		Padding(0, 1)
+func (m Model) renderBulkInputView() string {
+	var s strings.Builder
+	prompts := map[bulkAction]string{
+		bulkTag:    i18n.T("view.bulk.prompt.tag"),
+		bulkStatus: i18n.T("view.bulk.prompt.status"),
+		bulkExport: i18n.T("view.bulk.prompt.export"),
+	}
+	s.WriteString(m.styles.Header.Render(i18n.T("view.bulk.header", len(m.selectedIDs()))))
+	s.WriteString("\n\n")
+	s.WriteString(prompts[m.bulkAction] + "\n")
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
+	s.WriteString("\n")
+	s.WriteString(m.formatKeyHelp("Enter", i18n.T("help.continue"), "Esc", i18n.T("help.back")))
+	return s.String()
 }
 
-func (m Model) getErrorStyle() lipgloss.Style {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("9")).
-		Bold(true)
+// maxConfirmListed limits how many tickets the bulk confirmation lists
+const maxConfirmListed = 10
+
+// confirmBulkDialog returns the text and the answers of the bulk action confirmation
+func (m Model) confirmBulkDialog() (string, []string) {
+	var s strings.Builder
+	tickets := m.storage.TicketsByID(m.selectedIDs())
+	s.WriteString(m.styles.Header.Render(i18n.T("view.confirm.header")))
+	s.WriteString("\n\n")
+	s.WriteString(m.bulkAction.describe(len(tickets), m.bulkArg) + "\n\n")
+	for i, ticket := range tickets {
+		if i == maxConfirmListed {
+			s.WriteString("  " + i18n.T("view.confirm.more", len(tickets)-maxConfirmListed) + "\n")
+			break
+		}
+		s.WriteString("  " + ticket.GetTitle() + "\n")
+	}
+	s.WriteString("\n")
+	switch m.bulkAction {
+	case bulkDelete, bulkArchive, bulkUnarchive, bulkTag, bulkStatus:
+		s.WriteString(i18n.T("view.confirm.backup") + "\n")
+	}
+	return s.String(), []string{m.keys.Confirm.Help().Key, i18n.T("help.run"), m.keys.Deny.Help().Key, i18n.T("help.cancel")}
 }
 
-func (m Model) getKeyStyle() lipgloss.Style {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("12")).
-		Bold(true)
+func (m Model) renderRecentView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.recent.header")))
+	s.WriteString("\n\n")
+	now := time.Now()
+	for i, ticket := range m.recent {
+		line := fmt.Sprintf("%s  %s", ticket.GetTitle(), m.styles.Help.Render(relativeTime(ticket.LastUsedAt(), now)))
+		if i == m.recentIndex {
+			s.WriteString(m.styles.renderSelected(fmt.Sprintf("%s  %s", ticket.GetTitle(), relativeTime(ticket.LastUsedAt(), now))))
+		} else {
+			s.WriteString("  " + line)
+		}
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(m.formatKeyHelp(m.keys.Up.Help().Key+"/"+m.keys.Down.Help().Key, i18n.T("help.navigate"), m.keys.Choose.Help().Key, i18n.T("help.show_in_list"), "o", i18n.T("help.open"), "c", i18n.T("help.copy"), "v", i18n.T("help.details"), m.keys.Back.Help().Key, i18n.T("help.back")))
+	return s.String()
 }
 
-func (m Model) getActionStyle() lipgloss.Style {
-	return lipgloss.NewStyle().
-		Foreground(lipgloss.Color("250"))
+func (m Model) renderDetailView() string {
+	var s strings.Builder
+	ticket, ok := m.detailTicket()
+	if !ok {
+		return i18n.T("view.detail.not_found")
+	}
+	now := time.Now()
+	usage := func(at *time.Time, count int) string {
+		if at == nil {
+			return i18n.T("view.detail.never")
+		}
+		return i18n.T("view.detail.usage", relativeTime(*at, now), at.Format("02.01.2006 15:04"), count)
+	}
+
+	s.WriteString(m.styles.Header.Render(ticket.GetTitle()))
+	s.WriteString("\n\n")
+	rows := [][2]string{
+		{i18n.T("view.detail.link"), ticket.URL},
+		{i18n.T("view.detail.key"), ticket.Key},
+		{i18n.T("view.detail.status"), ticket.Status},
+		{i18n.T("view.detail.tags"), strings.Join(ticket.Tags, ", ")},
+		{i18n.T("view.detail.created"), fmt.Sprintf("%s (%s)", relativeTime(ticket.CreatedAt, now), ticket.CreatedAt.Format("02.01.2006 15:04"))},
+		{i18n.T("view.detail.opened"), usage(ticket.LastOpenedAt, ticket.OpenCount)},
+		{i18n.T("view.detail.copied"), usage(ticket.LastCopiedAt, ticket.CopyCount)},
+	}
+	if ticket.Pinned {
+		rows = append(rows, [2]string{i18n.T("view.detail.pinned"), i18n.T("view.detail.yes")})
+	}
+	if ticket.Archived {
+		rows = append(rows, [2]string{i18n.T("view.detail.archived"), i18n.T("view.detail.yes")})
+	}
+	for _, row := range rows {
+		if row[1] == "" {
+			continue
+		}
+		s.WriteString(fmt.Sprintf("%s %s\n", m.styles.Key.Render(fmt.Sprintf("%-11s", row[0]+":")), row[1]))
+	}
+	s.WriteString("\n")
+	s.WriteString(m.formatKeyHelp("o", i18n.T("help.open"), "Enter", i18n.T("help.copy"), m.keys.Back.Help().Key, i18n.T("help.back")))
+	return s.String()
+}
+
+// confirmRestoreDialog returns the text and the answers of the restore confirmation
+func (m Model) confirmRestoreDialog() (string, []string) {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.restore.header")))
+	s.WriteString("\n\n")
+	s.WriteString(i18n.T("view.restore.question") + "\n")
+	s.WriteString(i18n.T("view.restore.backup", m.backupToRestore) + "\n\n")
+	s.WriteString("⚠️  " + i18n.T("view.restore.warning") + "\n")
+	return s.String(), []string{m.keys.Confirm.Help().Key, i18n.T("help.yes_restore"), m.keys.Deny.Help().Key, i18n.T("help.no_cancel")}
+}
+
+func (m Model) renderHelpView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.help.header")))
+	s.WriteString("\n\n")
+	lines := m.helpLines()
+	end := min(m.helpOffset+m.helpHeight(), len(lines))
+	for _, line := range lines[m.helpOffset:end] {
+		s.WriteString(line + "\n")
+	}
+	if len(lines) > m.helpHeight() {
+		s.WriteString(m.styles.Action.Render(i18n.T("view.help.lines", m.helpOffset+1, end, len(lines))) + "\n")
+	}
+	s.WriteString(m.formatKeyHelp(m.keys.Up.Help().Key+"/"+m.keys.Down.Help().Key, i18n.T("help.scroll"), m.keys.Back.Help().Key+"/"+m.keys.Help.Help().Key, i18n.T("help.close_help")))
+	return s.String()
 }
 
-func (m Model) getHelpStyle() lipgloss.Style {
-	return lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		Padding(0, 1).
-		MarginTop(1)
+func (m Model) renderPaletteView() string {
+	var s strings.Builder
+	s.WriteString(m.styles.Header.Render(i18n.T("view.palette.header")))
+	s.WriteString("\n\n")
+	s.WriteString(m.styles.Input.Render(m.textInput.View()))
+	s.WriteString("\n")
+
+	if len(m.paletteMatches) == 0 {
+		s.WriteString(i18n.T("view.palette.empty") + "\n")
+	}
+	// Keep the selected command inside the visible window
+	start := max(m.paletteIndex-maxPaletteRows+1, 0)
+	end := min(start+maxPaletteRows, len(m.paletteMatches))
+	for i := start; i < end; i++ {
+		match := m.paletteMatches[i]
+		if i == m.paletteIndex {
+			line := styleMatched(match.command.title, match.matchedIndexes, 0, m.styles.Selected.Bold(true).Underline(true), m.styles.Selected)
+			if match.command.keys != "" {
+				line += m.styles.Selected.Render("  " + match.command.keys)
+			}
+			s.WriteString(m.styles.Selected.Padding(0, 1).Render(m.styles.Selected.Render("> ") + line))
+		} else {
+			line := styleMatched(match.command.title, match.matchedIndexes, 0, m.styles.Match, lipgloss.NewStyle())
+			if match.command.keys != "" {
+				line += "  " + m.styles.Key.Render(match.command.keys)
+			}
+			s.WriteString("  " + line)
+		}
+		s.WriteString("\n")
+	}
+	if len(m.paletteMatches) > maxPaletteRows {
+		s.WriteString(m.styles.Action.Render(i18n.T("view.palette.count", len(m.paletteMatches))) + "\n")
+	}
+	s.WriteString(m.formatKeyHelp("↑/↓", i18n.T("help.navigate"), "Enter", i18n.T("help.run"), "Esc", i18n.T("help.cancel")))
+	return s.String()
 }
 
 // formatKeyHelp formats key help pairs
@@ -219,7 +583,7 @@ func (m Model) formatKeyHelp(pairs ...string) string {
 	for i := 0; i < len(pairs); i += 2 {
 		key := pairs[i]
 		action := pairs[i+1]
-		helpParts = append(helpParts, m.getKeyStyle().Render(key)+" - "+m.getActionStyle().Render(action))
+		helpParts = append(helpParts, m.styles.Key.Render(key)+" - "+m.styles.Action.Render(action))
 	}
-	return m.getHelpStyle().Render(strings.Join(helpParts, " • "))
+	return m.styles.Help.Render(strings.Join(helpParts, " • "))
 }
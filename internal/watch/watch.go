@@ -0,0 +1,98 @@
+// Package watch reports changes of a file made by other programs. It uses the
+// native notification API of the platform where there is one and polls the
+// file otherwise.
+package watch
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is how often the polling watcher checks the file
+const DefaultPollInterval = time.Second
+
+// Watcher reports changes of one file on its Changes channel
+type Watcher struct {
+	changes chan struct{}
+	done    chan struct{}
+	stop    func()
+	once    sync.Once
+}
+
+func newWatcher(stop func()) *Watcher {
+	return &Watcher{
+		// One pending notification is enough: the receiver reads the file anyway
+		changes: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+		stop:    stop,
+	}
+}
+
+// New watches path with the native notification API and falls back to
+// polling when it is not available
+func New(path string) *Watcher {
+	if w, err := newNative(path); err == nil {
+		return w
+	}
+	return NewPolling(path, DefaultPollInterval)
+}
+
+// NewPolling watches path by comparing its size and modification time every interval
+func NewPolling(path string, interval time.Duration) *Watcher {
+	w := newWatcher(nil)
+	last := statFile(path)
+	go func() {
+		defer close(w.changes)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.done:
+				return
+			case <-ticker.C:
+				if current := statFile(path); current != last {
+					last = current
+					w.notify()
+				}
+			}
+		}
+	}()
+	return w
+}
+
+// Changes receives a value after the file changed; it is closed by Close
+func (w *Watcher) Changes() <-chan struct{} {
+	return w.changes
+}
+
+// Close stops watching
+func (w *Watcher) Close() {
+	w.once.Do(func() {
+		close(w.done)
+		if w.stop != nil {
+			w.stop()
+		}
+	})
+}
+
+func (w *Watcher) notify() {
+	select {
+	case w.changes <- struct{}{}:
+	default:
+	}
+}
+
+// fileStat is what the polling watcher compares; a missing file is the zero value
+type fileStat struct {
+	size    int64
+	modTime time.Time
+}
+
+func statFile(path string) fileStat {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStat{}
+	}
+	return fileStat{size: info.Size(), modTime: info.ModTime()}
+}
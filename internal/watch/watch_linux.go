@@ -0,0 +1,54 @@
+//go:build linux
+
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// inotifyMask covers in-place writes as well as files replaced by a rename
+const inotifyMask = unix.IN_CLOSE_WRITE | unix.IN_MOVED_TO | unix.IN_CREATE | unix.IN_DELETE
+
+// newNative watches the directory of path with inotify, so the watch survives
+// the file being replaced, and reports the events about the file itself
+func newNative(path string) (*Watcher, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := unix.InotifyAddWatch(fd, filepath.Dir(path), inotifyMask); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	// A non-blocking descriptor goes through the runtime poller, so closing
+	// the file unblocks the pending Read
+	file := os.NewFile(uintptr(fd), "inotify")
+	w := newWatcher(func() { file.Close() })
+	name := filepath.Base(path)
+
+	go func() {
+		defer close(w.changes)
+		buf := make([]byte, 4096)
+		for {
+			n, err := file.Read(buf)
+			if err != nil {
+				return
+			}
+			for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+				event := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+				start := offset + unix.SizeofInotifyEvent
+				end := min(start+int(event.Len), n)
+				if strings.TrimRight(string(buf[start:end]), "\x00") == name {
+					w.notify()
+				}
+				offset = end
+			}
+		}
+	}()
+	return w, nil
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package watch
+
+import "errors"
+
+// newNative is only implemented on Linux; other platforms poll
+func newNative(path string) (*Watcher, error) {
+	return nil, errors.New("file notifications are not supported on this platform")
+}
@@ -13,12 +13,25 @@ type TicketStorage = storage.TicketStorage
 // ImportResult type alias for backward compatibility
 type ImportResult = storage.ImportResult
 
+// SavedSearch type alias for backward compatibility
+type SavedSearch = storage.SavedSearch
+
 // FileSystem type alias for backward compatibility
 type FileSystem = storage.FileSystem
 
 // RealFileSystem type alias for backward compatibility
 type RealFileSystem = storage.RealFileSystem
 
+// Ошибки операций с тикетами, проверяются через errors.Is
+var (
+	ErrDuplicateURL = storage.ErrDuplicateURL
+	ErrNotFound     = storage.ErrNotFound
+	ErrInvalidURL   = storage.ErrInvalidURL
+	ErrEmptyTitle   = storage.ErrEmptyTitle
+	ErrBackupFailed = storage.ErrBackupFailed
+	ErrEmptyName    = storage.ErrEmptyName
+)
+
 // Функции-обертки для обратной совместимости
 
 // NewTicketStorage создает новое хранилище тикетов
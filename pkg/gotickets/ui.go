@@ -17,24 +17,69 @@ type ViewMode = ui.ViewMode
 
 // ViewMode constants for backward compatibility
 const (
-	ViewList           = ui.ViewList
-	ViewAddURL         = ui.ViewAddURL
-	ViewAddTitle       = ui.ViewAddTitle
-	ViewConfirmDelete  = ui.ViewConfirmDelete
-	ViewImport         = ui.ViewImport
-	ViewImportResult   = ui.ViewImportResult
-	ViewBackups        = ui.ViewBackups
-	ViewConfirmRestore = ui.ViewConfirmRestore
+	ViewList                = ui.ViewList
+	ViewAddURL              = ui.ViewAddURL
+	ViewAddTitle            = ui.ViewAddTitle
+	ViewConfirmDelete       = ui.ViewConfirmDelete
+	ViewImport              = ui.ViewImport
+	ViewImportResult        = ui.ViewImportResult
+	ViewBackups             = ui.ViewBackups
+	ViewConfirmRestore      = ui.ViewConfirmRestore
+	ViewImportPreview       = ui.ViewImportPreview
+	ViewSavedSearches       = ui.ViewSavedSearches
+	ViewSaveSearch          = ui.ViewSaveSearch
+	ViewBulkActions         = ui.ViewBulkActions
+	ViewBulkInput           = ui.ViewBulkInput
+	ViewConfirmBulk         = ui.ViewConfirmBulk
+	ViewRecent              = ui.ViewRecent
+	ViewDetail              = ui.ViewDetail
+	ViewHelp                = ui.ViewHelp
+	ViewPalette             = ui.ViewPalette
+	ViewConfirmReload       = ui.ViewConfirmReload
+	ViewBackupInput         = ui.ViewBackupInput
+	ViewConfirmBackupDelete = ui.ViewConfirmBackupDelete
 )
 
+// KeyMap type alias for the configurable key bindings
+type KeyMap = ui.KeyMap
+
+// TicketsChangedMsg type alias for the message sent when tickets.json changes on disk
+type TicketsChangedMsg = ui.TicketsChangedMsg
+
+// NewKeyMap builds a keymap from a preset and overrides by action name
+func NewKeyMap(preset string, overrides map[string][]string) (KeyMap, error) {
+	return ui.NewKeyMap(preset, overrides)
+}
+
+// Theme type alias for the color themes
+type Theme = ui.Theme
+
+// Styles type alias for the styles built from a theme
+type Styles = ui.Styles
+
+// ResolveTheme picks a theme by name; noColor selects the no-color theme
+func ResolveTheme(name string, noColor bool) (Theme, error) {
+	return ui.ResolveTheme(name, noColor)
+}
+
+// NewStyles builds the styles of a theme
+func NewStyles(theme Theme) Styles {
+	return ui.NewStyles(theme)
+}
+
 // NewModel creates a new UI model
 func NewModel() Model {
 	return Model{ui.NewModel()}
 }
 
+// NewModelWithFS creates a new UI model on top of the given file system
+func NewModelWithFS(fs FileSystem) Model {
+	return Model{ui.NewModelWithFS(fs)}
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
-	return textinput.Blink
+	return tea.Batch(textinput.Blink, m.Model.Init())
 }
 
 // Update handles all input events
@@ -44,8 +89,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.SetListSize(msg.Width, msg.Height-10) // Leave room for title with border and status
 		return m, nil
 
+	case ui.ToastExpiredMsg:
+		return Model{m.HandleToastExpired(msg)}, nil
+
+	case ui.TicketsChangedMsg:
+		model, cmd := m.HandleTicketsChanged(msg)
+		return Model{model}, cmd
+
+	case tea.MouseMsg:
+		model, cmd := m.HandleMouse(msg)
+		return Model{model}, cmd
+
 	case tea.KeyMsg:
-		if m.IsSearchMode() {
+		if m.IsSearchMode() && m.GetViewMode() == ViewList {
 			model, cmd := m.HandleSearch(msg)
 			return Model{model}, cmd
 		}
@@ -75,6 +131,45 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case ViewConfirmRestore:
 			model, cmd := m.HandleConfirmRestore(msg)
 			return Model{model}, cmd
+		case ViewImportPreview:
+			model, cmd := m.HandleImportPreview(msg)
+			return Model{model}, cmd
+		case ViewSavedSearches:
+			model, cmd := m.HandleSavedSearches(msg)
+			return Model{model}, cmd
+		case ViewSaveSearch:
+			model, cmd := m.HandleSaveSearch(msg)
+			return Model{model}, cmd
+		case ViewBulkActions:
+			model, cmd := m.HandleBulkActions(msg)
+			return Model{model}, cmd
+		case ViewBulkInput:
+			model, cmd := m.HandleBulkInput(msg)
+			return Model{model}, cmd
+		case ViewConfirmBulk:
+			model, cmd := m.HandleConfirmBulk(msg)
+			return Model{model}, cmd
+		case ViewRecent:
+			model, cmd := m.HandleRecent(msg)
+			return Model{model}, cmd
+		case ViewDetail:
+			model, cmd := m.HandleDetail(msg)
+			return Model{model}, cmd
+		case ViewHelp:
+			model, cmd := m.HandleHelp(msg)
+			return Model{model}, cmd
+		case ViewPalette:
+			model, cmd := m.HandlePalette(msg)
+			return Model{model}, cmd
+		case ViewConfirmReload:
+			model, cmd := m.HandleConfirmReload(msg)
+			return Model{model}, cmd
+		case ViewBackupInput:
+			model, cmd := m.HandleBackupInput(msg)
+			return Model{model}, cmd
+		case ViewConfirmBackupDelete:
+			model, cmd := m.HandleConfirmBackupDelete(msg)
+			return Model{model}, cmd
 		}
 	}
 
@@ -114,11 +114,27 @@ func (fs *MockFileSystem) Open(name string) (*os.File, error) {
 	return nil, os.ErrNotExist
 }
 
+func (fs *MockFileSystem) Remove(name string) error {
+	if err, exists := fs.errors["Remove"]; exists {
+		return err
+	}
+	if _, exists := fs.files[name]; !exists {
+		return os.ErrNotExist
+	}
+	delete(fs.files, name)
+	return nil
+}
+
 // SetError sets an error for a specific method
 func (fs *MockFileSystem) SetError(method string, err error) {
 	fs.errors[method] = err
 }
 
+// ClearError removes a previously set error for a method
+func (fs *MockFileSystem) ClearError(method string) {
+	delete(fs.errors, method)
+}
+
 type mockFileInfo struct {
 	name string
 	size int64
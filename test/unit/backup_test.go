@@ -0,0 +1,271 @@
+package unit
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gotickets/internal/storage"
+	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeBackup puts a backup holding the given tickets into the data directory
+func writeBackup(t *testing.T, mockFS *mocks.MockFileSystem, name string, titles ...string) {
+	t.Helper()
+	backup := storage.NewTicketStorage(mockFS)
+	for i, title := range titles {
+		backup.Tickets = append(backup.Tickets, storage.Ticket{ID: i + 1, Title: title, URL: "https://example.com/" + title})
+	}
+	data, err := json.Marshal(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataDir, _ := storage.DataDir(mockFS)
+	if err := mockFS.WriteFile(filepath.Join(dataDir, name), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func backupNames(t *testing.T, fs storage.FileSystem) []string {
+	t.Helper()
+	backups, err := storage.ListBackupInfos(fs)
+	if err != nil {
+		t.Fatalf("failed to list backups: %v", err)
+	}
+	names := make([]string, len(backups))
+	for i, backup := range backups {
+		names[i] = backup.Name
+	}
+	return names
+}
+
+func TestBackups_ListNewestFirstWithDetails(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	writeBackup(t, mockFS, "tickets_backup_2026-01-02_10-00-00.json", "a", "b")
+	writeBackup(t, mockFS, "tickets_backup_2026-03-01_09-00-00.json", "a")
+	dataDir, _ := storage.DataDir(mockFS)
+	if err := mockFS.WriteFile(filepath.Join(dataDir, "tickets_backup_2026-03-01_09-00-00_2.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := storage.ListBackupInfos(mockFS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, backup := range backups {
+		got = append(got, backup.CreatedAt.Format("01-02 15:04"))
+	}
+	if strings.Join(got, ",") != "03-01 09:00,03-01 09:00,01-02 10:00" {
+		t.Fatalf("expected newest first, got %v", got)
+	}
+	if backups[0].Tickets != -1 || backups[1].Tickets != 1 || backups[2].Tickets != 2 {
+		t.Fatalf("expected ticket counts -1, 1, 2, got %+v", backups)
+	}
+	if backups[1].Size == 0 {
+		t.Fatal("expected the backup size to be known")
+	}
+}
+
+func TestBackups_RecordOperationAndLabel(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	for _, title := range []string{"First", "Second"} {
+		if _, err := ticketStorage.AddTicket(title, "https://example.com/"+strings.ToLower(title)); err != nil {
+			t.Fatal(err)
+		}
+		if err := ticketStorage.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ticketStorage.DeleteTicket(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := storage.CreateBackup(mockFS, storage.BackupManual, "  before cleanup "); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := storage.ListBackupInfos(mockFS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first ticket was added before tickets.json existed, so it has no backup
+	want := []storage.BackupOperation{storage.BackupManual, storage.BackupDelete, storage.BackupAdd}
+	if len(backups) != len(want) {
+		t.Fatalf("expected %d backups, got %+v", len(want), backups)
+	}
+	for i, operation := range want {
+		if backups[i].Operation != operation {
+			t.Fatalf("expected backup %d to be made by %q, got %q", i, operation, backups[i].Operation)
+		}
+	}
+	if backups[0].Label != "before cleanup" {
+		t.Fatalf("expected the label to be trimmed, got %q", backups[0].Label)
+	}
+}
+
+func TestBackups_DeleteAndExport(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	if _, err := ticketStorage.AddTicket("First", "https://example.com/first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatal(err)
+	}
+	name, err := storage.CreateBackup(mockFS, storage.BackupManual, "keep")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported := filepath.Join(t.TempDir(), "export.json")
+	if err := storage.ExportBackup(mockFS, name, exported); err != nil {
+		t.Fatal(err)
+	}
+	if loaded, _ := storage.LoadTicketsFromPathWithFS(mockFS, exported); len(loaded.Tickets) != 1 {
+		t.Fatalf("expected the export to hold the backed up ticket, got %+v", loaded.Tickets)
+	}
+
+	if err := storage.DeleteBackup(mockFS, "../tickets.json"); err == nil {
+		t.Fatal("expected only backups to be deletable")
+	}
+	// A file system without Remove cannot delete backups
+	if err := storage.DeleteBackup(struct{ storage.FileSystem }{mockFS}, name); err == nil {
+		t.Fatal("expected an error from a file system that cannot delete files")
+	}
+	if err := storage.DeleteBackup(mockFS, name); err != nil {
+		t.Fatal(err)
+	}
+	if names := backupNames(t, mockFS); len(names) != 0 {
+		t.Fatalf("expected the backup to be deleted, got %v", names)
+	}
+	dataDir, _ := storage.DataDir(mockFS)
+	index, _ := mockFS.ReadFile(filepath.Join(dataDir, "backups.json"))
+	if strings.Contains(string(index), name) {
+		t.Fatalf("expected the backup to be dropped from the index, got %s", index)
+	}
+}
+
+// newBackupTestModel returns a model with saved tickets and three backups
+func newBackupTestModel(t *testing.T) (gotickets.Model, *mocks.MockFileSystem) {
+	t.Helper()
+	model, mockFS := newTestModel(t, "Current")
+	writeBackup(t, mockFS, "tickets_backup_2026-01-02_10-00-00.json", "Old", "Older")
+	writeBackup(t, mockFS, "tickets_backup_2026-02-03_11-00-00.json", "Middle")
+	writeBackup(t, mockFS, "tickets_backup_2026-03-04_12-00-00.json")
+	dataDir, _ := storage.DataDir(mockFS)
+	index := `{"tickets_backup_2026-02-03_11-00-00.json": {"operation": "import", "label": "release"}}`
+	if err := mockFS.WriteFile(filepath.Join(dataDir, "backups.json"), []byte(index), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return sendKeys(t, model, runes("b")), mockFS
+}
+
+// runCmd runs a command and feeds the messages it produces back into the
+// model; commands still waiting after a short while (cursor blinks) are dropped
+func runCmd(t *testing.T, model gotickets.Model, cmd tea.Cmd) gotickets.Model {
+	t.Helper()
+	if cmd == nil {
+		return model
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(100 * time.Millisecond):
+		return model
+	}
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			model = runCmd(t, model, c)
+		}
+		return model
+	}
+	if msg == nil {
+		return model
+	}
+	updated, next := model.Update(msg)
+	return runCmd(t, updated.(gotickets.Model), next)
+}
+
+func TestBackupBrowser_ShowsBackupsNewestFirst(t *testing.T) {
+	model, _ := newBackupTestModel(t)
+
+	if model.GetViewMode() != gotickets.ViewBackups {
+		t.Fatalf("expected the backup browser, got view %v", model.GetViewMode())
+	}
+	view := model.View()
+	newest := strings.Index(view, "2026-03-04 12:00:00")
+	oldest := strings.Index(view, "2026-01-02 10:00:00")
+	if newest < 0 || oldest < 0 || newest > oldest {
+		t.Fatalf("expected backups newest first, got:\n%s", view)
+	}
+	for _, detail := range []string{"тикетов: 2", "операция неизвестна", "release", "импорт", "Найдено резервных копий: 3"} {
+		if !strings.Contains(view, detail) {
+			t.Fatalf("expected %q in the browser, got:\n%s", detail, view)
+		}
+	}
+}
+
+func TestBackupBrowser_Filter(t *testing.T) {
+	model, _ := newBackupTestModel(t)
+
+	model = sendKeys(t, model, runes("/"))
+	updated, cmd := model.Update(runes("release"))
+	model = runCmd(t, updated.(gotickets.Model), cmd)
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEnter})
+
+	view := model.View()
+	if !strings.Contains(view, "Показано 1 из 3") || strings.Contains(view, "2026-03-04") {
+		t.Fatalf("expected only the matching backup, got:\n%s", view)
+	}
+
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc})
+	if model.GetViewMode() != gotickets.ViewBackups || !strings.Contains(model.View(), "2026-03-04") {
+		t.Fatalf("expected Esc to clear the filter first, got:\n%s", model.View())
+	}
+}
+
+func TestBackupBrowser_DeleteExportAndCreate(t *testing.T) {
+	model, mockFS := newBackupTestModel(t)
+
+	// The cursor starts on the newest backup
+	model = sendKeys(t, model, runes("d"), runes("y"))
+	if names := backupNames(t, mockFS); len(names) != 2 || names[0] != "tickets_backup_2026-02-03_11-00-00.json" {
+		t.Fatalf("expected the newest backup to be deleted, got %v", names)
+	}
+
+	exported := filepath.Join(t.TempDir(), "middle.json")
+	model = sendKeys(t, model, runes("e"), runes(exported), tea.KeyMsg{Type: tea.KeyEnter})
+	if loaded, _ := storage.LoadTicketsFromPathWithFS(mockFS, exported); len(loaded.Tickets) != 1 || loaded.Tickets[0].Title != "Middle" {
+		t.Fatalf("expected the selected backup to be exported, got %+v", loaded.Tickets)
+	}
+
+	model = sendKeys(t, model, runes("n"), runes("before cleanup"), tea.KeyMsg{Type: tea.KeyEnter})
+	if model.GetViewMode() != gotickets.ViewBackups {
+		t.Fatalf("expected to return to the browser, got view %v", model.GetViewMode())
+	}
+	if view := model.View(); !strings.Contains(view, "before cleanup") || !strings.Contains(view, "вручную") {
+		t.Fatalf("expected the new labelled backup in the browser, got:\n%s", view)
+	}
+	if names := backupNames(t, mockFS); len(names) != 3 {
+		t.Fatalf("expected a new backup, got %v", names)
+	}
+}
+
+func TestBackupBrowser_DoubleClickAsksToRestore(t *testing.T) {
+	model, _ := newBackupTestModel(t)
+
+	x, y := screenPosition(t, model, "2026-01-02 10:00:00")
+	model = sendMouse(t, model, click(x, y), click(x, y))
+
+	if model.GetViewMode() != gotickets.ViewConfirmRestore || !strings.Contains(model.View(), "tickets_backup_2026-01-02_10-00-00.json") {
+		t.Fatalf("expected to be asked to restore the clicked backup, got:\n%s", model.View())
+	}
+}
@@ -0,0 +1,123 @@
+package unit
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotickets/internal/storage"
+	"gotickets/test/mocks"
+)
+
+func bulkStorage(t *testing.T) (*storage.TicketStorage, *mocks.MockFileSystem) {
+	t.Helper()
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	for i, url := range []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"} {
+		if _, err := ticketStorage.AddTicket("Ticket "+string(rune('A'+i)), url); err != nil {
+			t.Fatalf("failed to add ticket: %v", err)
+		}
+	}
+	return ticketStorage, mockFS
+}
+
+func backupCount(t *testing.T, fs storage.FileSystem) int {
+	t.Helper()
+	backups, err := storage.ListBackupsUsing(fs)
+	if err != nil {
+		t.Fatalf("failed to list backups: %v", err)
+	}
+	return len(backups)
+}
+
+func TestBulk_DeleteUsesSingleBackup(t *testing.T) {
+	ticketStorage, mockFS := bulkStorage(t)
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save tickets: %v", err)
+	}
+	before := backupCount(t, mockFS)
+
+	deleted, err := ticketStorage.DeleteTickets([]int{1, 3, 99})
+	if err != nil || deleted != 2 {
+		t.Fatalf("expected 2 deleted tickets, got %d, %v", deleted, err)
+	}
+	if len(ticketStorage.Tickets) != 1 || ticketStorage.Tickets[0].ID != 2 {
+		t.Fatalf("unexpected tickets left: %+v", ticketStorage.Tickets)
+	}
+	if after := backupCount(t, mockFS); after != before+1 {
+		t.Fatalf("expected exactly one new backup, got %d -> %d", before, after)
+	}
+
+	if _, err := ticketStorage.DeleteTickets([]int{99}); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestBulk_BackupFailureLeavesTicketsUnchanged(t *testing.T) {
+	ticketStorage, mockFS := bulkStorage(t)
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save tickets: %v", err)
+	}
+	mockFS.SetError("ReadFile", mocks.AssertErr("read failure"))
+
+	if _, err := ticketStorage.ArchiveTickets([]int{1, 2}, true); !errors.Is(err, storage.ErrBackupFailed) {
+		t.Fatalf("expected ErrBackupFailed, got %v", err)
+	}
+	if len(ticketStorage.ActiveTickets()) != 3 {
+		t.Fatal("expected no ticket to be archived when the backup fails")
+	}
+}
+
+func TestBulk_ArchiveTagStatus(t *testing.T) {
+	ticketStorage, _ := bulkStorage(t)
+
+	if _, err := ticketStorage.ArchiveTickets([]int{1}, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ticketStorage.TagTickets([]int{2, 3}, "sprint-12"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ticketStorage.TagTickets([]int{2}, "SPRINT-12"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ticketStorage.SetStatus([]int{3}, "done"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ticketStorage.TagTickets([]int{2}, "two words"); err == nil {
+		t.Fatal("expected a tag with spaces to be rejected")
+	}
+
+	if active := ticketStorage.ActiveTickets(); len(active) != 2 {
+		t.Fatalf("expected archived ticket to be hidden, got %+v", active)
+	}
+	tickets, _ := ticketStorage.Query("tag:sprint-12 -status:done")
+	if len(tickets) != 1 || tickets[0].ID != 2 || len(tickets[0].Tags) != 1 {
+		t.Fatalf("unexpected query result: %+v", tickets)
+	}
+	if tickets, _ := ticketStorage.Query("archived:yes"); len(tickets) != 1 || tickets[0].ID != 1 {
+		t.Fatalf("expected archived:yes to find the archived ticket, got %+v", tickets)
+	}
+	if tickets := ticketStorage.Search("Ticket A"); len(tickets) != 0 {
+		t.Fatalf("expected fuzzy search to skip archived tickets, got %+v", tickets)
+	}
+}
+
+func TestBulk_ExportIsImportable(t *testing.T) {
+	ticketStorage, mockFS := bulkStorage(t)
+	exportPath := filepath.Join(t.TempDir(), "export.txt")
+
+	if count, err := ticketStorage.ExportTickets(exportPath, []int{1, 2}); err != nil || count != 2 {
+		t.Fatalf("expected 2 exported tickets, got %d, %v", count, err)
+	}
+	data, _ := mockFS.ReadFile(exportPath)
+	if !strings.Contains(string(data), "https://example.com/2 - Ticket B") {
+		t.Fatalf("unexpected export:\n%s", data)
+	}
+
+	target := storage.NewTicketStorage(mocks.NewMockFileSystem(t.TempDir()))
+	result, err := target.Import(strings.NewReader(string(data)), nil)
+	if err != nil || result.Added != 2 {
+		t.Fatalf("expected the export to import back, got %+v, %v", result, err)
+	}
+}
@@ -0,0 +1,201 @@
+package unit
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotickets/internal/cli"
+	"gotickets/internal/storage"
+	"gotickets/test/mocks"
+)
+
+func TestCLI_ImportDryRun_DoesNotSave(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	importPath := filepath.Join(tempDir, "import.txt")
+	if err := mockFS.WriteFile(importPath, []byte("https://example.com/1 - One\nbroken\nhttps://example.com/2\tTwo\n"), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{FS: mockFS, Stdout: &stdout, Stderr: &stderr}
+	if code := cli.Run(env, []string{"import", "--dry-run", importPath}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "+ строка 1") || !strings.Contains(stdout.String(), "! строка 2") {
+		t.Fatalf("unexpected dry-run output:\n%s", stdout.String())
+	}
+	if !strings.Contains(stdout.String(), `Формат url\ttitle, строк: 1`) {
+		t.Fatalf("expected the format counts, got:\n%s", stdout.String())
+	}
+
+	ticketStorage, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(ticketStorage.Tickets) != 0 {
+		t.Fatalf("dry run must not save tickets, got %d", len(ticketStorage.Tickets))
+	}
+}
+
+func TestCLI_Import_SavesTickets(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	importPath := filepath.Join(tempDir, "import.txt")
+	if err := mockFS.WriteFile(importPath, []byte("https://example.com/1 - One\n"), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{FS: mockFS, Stdout: &stdout, Stderr: &stderr}
+	if code := cli.Run(env, []string{"import", importPath}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+
+	ticketStorage, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(ticketStorage.Tickets) != 1 {
+		t.Fatalf("expected 1 saved ticket, got %d", len(ticketStorage.Tickets))
+	}
+}
+
+func TestCLI_ImportFromStdin(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{
+		FS:     mockFS,
+		Stdin:  strings.NewReader("https://example.com/1\tOne\nhttps://example.com/2\tTwo\n"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+	if code := cli.Run(env, []string{"import", "--format", "tsv", "-"}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+
+	ticketStorage, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(ticketStorage.Tickets) != 2 || ticketStorage.Tickets[1].Title != "Two" {
+		t.Fatalf("unexpected tickets after stdin import: %+v", ticketStorage.Tickets)
+	}
+}
+
+func TestCLI_AddByKey(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	if err := mockFS.WriteFile(configPath, []byte(`{"url_templates": {"PROJ": "https://jira.local/browse/{key}"}}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{FS: mockFS, Stdout: &stdout, Stderr: &stderr}
+	if code := cli.Run(env, []string{"add", "PROJ-1234"}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if code := cli.Run(env, []string{"add", "PROJ-1234"}); code == 0 {
+		t.Fatal("expected adding the same key twice to fail")
+	}
+
+	ticketStorage, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(ticketStorage.Tickets) != 1 {
+		t.Fatalf("expected 1 ticket, got %d", len(ticketStorage.Tickets))
+	}
+	ticket := ticketStorage.Tickets[0]
+	if ticket.URL != "https://jira.local/browse/PROJ-1234" || ticket.Title != "PROJ-1234" || ticket.Key != "PROJ-1234" {
+		t.Fatalf("unexpected ticket: %+v", ticket)
+	}
+}
+
+func TestCLI_Search(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Fix login bug", "https://github.com/org/repo/issues/10")
+	ticketStorage.AddTicket("Docs", "https://example.com/docs")
+	ticketStorage.AddTicket("WIP release notes", "https://example.com/notes")
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save tickets: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{FS: mockFS, Stdout: &stdout, Stderr: &stderr}
+	if code := cli.Run(env, []string{"search", "host:github.com", "login bug"}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "Fix login bug") || strings.Contains(stdout.String(), "Docs") {
+		t.Fatalf("unexpected search output:\n%s", stdout.String())
+	}
+
+	// A leading exclusion is part of the query, not a flag
+	stdout.Reset()
+	if code := cli.Run(env, []string{"search", "-wip"}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if strings.Contains(stdout.String(), "WIP release notes") || !strings.Contains(stdout.String(), "Docs") {
+		t.Fatalf("expected the excluded ticket to be left out:\n%s", stdout.String())
+	}
+
+	stderr.Reset()
+	if code := cli.Run(env, []string{"search", "created:yesterday"}); code != 2 || !strings.Contains(stderr.String(), "yesterday") {
+		t.Fatalf("expected a parse error, got %d: %s", code, stderr.String())
+	}
+}
+
+func TestCLI_ListSorted(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Beta", "https://example.com/task/10")
+	ticketStorage.AddTicket("Alpha", "https://example.com/task/9")
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save tickets: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{FS: mockFS, Stdout: &stdout, Stderr: &stderr}
+	if code := cli.Run(env, []string{"list", "--sort", "number"}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if out := stdout.String(); strings.Index(out, "Alpha") > strings.Index(out, "Beta") {
+		t.Fatalf("expected ticket 9 before ticket 10:\n%s", out)
+	}
+	if code := cli.Run(env, []string{"list", "--sort", "size"}); code != 2 {
+		t.Fatalf("expected exit code 2 for an unknown sort mode, got %d", code)
+	}
+}
+
+func TestCLI_PinUnpin(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("One", "https://example.com/task/101")
+	ticketStorage.AddTicket("Two", "https://example.com/task/102")
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save tickets: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	env := &cli.Env{FS: mockFS, Stdout: &stdout, Stderr: &stderr}
+	if code := cli.Run(env, []string{"pin", "102", "1"}); code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(loaded.PinnedTickets()) != 2 {
+		t.Fatalf("expected both tickets pinned, got %+v", loaded.Tickets)
+	}
+
+	if code := cli.Run(env, []string{"unpin", "101", "missing"}); code != 1 {
+		t.Fatalf("expected exit code 1 for an unknown ticket, got %d", code)
+	}
+	loaded, _ = storage.LoadTicketsWithFS(mockFS)
+	if pinned := loaded.PinnedTickets(); len(pinned) != 1 || pinned[0].Title != "Two" {
+		t.Fatalf("expected only ticket Two pinned, got %+v", pinned)
+	}
+
+	// Nothing changed, so a failing save is not even tried
+	mockFS.SetError("WriteFile", mocks.AssertErr("read-only"))
+	stderr.Reset()
+	if code := cli.Run(env, []string{"pin", "missing"}); code != 1 || strings.Contains(stderr.String(), "read-only") {
+		t.Fatalf("expected only the unknown ticket to be reported, got %d: %s", code, stderr.String())
+	}
+}
@@ -0,0 +1,47 @@
+package unit
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gotickets/internal/config"
+	"gotickets/test/mocks"
+)
+
+func TestConfig_LoadMissingFile(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+
+	cfg, err := config.Load(mockFS)
+	if err != nil {
+		t.Fatalf("expected missing config to be fine, got %v", err)
+	}
+	if cfg.TrackerBaseURL != "" {
+		t.Fatalf("unexpected default config: %+v", cfg)
+	}
+}
+
+func TestConfig_Load(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", config.FileName)
+
+	data := `{"tracker_base_url": "https://jira.example.com/browse/", "allowed_schemes": ["https"]}`
+	if err := mockFS.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	cfg, err := config.Load(mockFS)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	policy := cfg.URLPolicy()
+	if policy.TrackerBaseURL != "https://jira.example.com/browse/" || len(policy.AllowedSchemes) != 1 {
+		t.Fatalf("unexpected URL policy: %+v", policy)
+	}
+
+	if err := mockFS.WriteFile(configPath, []byte("{broken"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if _, err := config.Load(mockFS); err == nil {
+		t.Fatal("expected malformed config to return an error")
+	}
+}
@@ -0,0 +1,112 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"gotickets/internal/storage"
+)
+
+func TestParseImportFormat(t *testing.T) {
+	testCases := []struct {
+		spec       string
+		separator  string
+		titleFirst bool
+	}{
+		{"url - title", " - ", false},
+		{"title | url", " | ", true},
+		{"tsv", "\t", false},
+		{`title\turl`, "\t", true},
+		{"URL;Title", ";", false},
+	}
+	for _, tc := range testCases {
+		format, err := storage.ParseImportFormat(tc.spec)
+		if err != nil {
+			t.Fatalf("ParseImportFormat(%q) failed: %v", tc.spec, err)
+		}
+		if format.Separator != tc.separator || format.TitleFirst != tc.titleFirst {
+			t.Errorf("ParseImportFormat(%q) = %+v", tc.spec, format)
+		}
+	}
+
+	if format, err := storage.ParseImportFormat("auto"); err != nil || format != nil {
+		t.Errorf("expected auto to mean detection, got %+v, %v", format, err)
+	}
+	for _, spec := range []string{"urltitle", "title only", "re:(?P<url>\\S+)", "re:("} {
+		if _, err := storage.ParseImportFormat(spec); err == nil {
+			t.Errorf("expected ParseImportFormat(%q) to fail", spec)
+		}
+	}
+}
+
+func TestImportFormat_Split(t *testing.T) {
+	titleFirst, _ := storage.ParseImportFormat("title - url")
+	url, title, ok := titleFirst.Split("Fix - login - bug - https://example.com/1")
+	if !ok || url != "https://example.com/1" || title != "Fix - login - bug" {
+		t.Fatalf("unexpected split: %q %q %v", url, title, ok)
+	}
+
+	regex, err := storage.ParseImportFormat(`re:^#\d+ (?P<title>.+) <(?P<url>[^>]+)>$`)
+	if err != nil {
+		t.Fatalf("ParseImportFormat failed: %v", err)
+	}
+	url, title, ok = regex.Split("#12 Broken build <https://ci.example.com/12>")
+	if !ok || url != "https://ci.example.com/12" || title != "Broken build" {
+		t.Fatalf("unexpected regex split: %q %q %v", url, title, ok)
+	}
+}
+
+func TestPreviewImport_AutoDetectsFormats(t *testing.T) {
+	content := "https://example.com/1 - First - with dash\n" +
+		"Second - https://example.com/2\n" +
+		"https://example.com/3\tThird\n" +
+		"https://example.com/4 | Fourth\n" +
+		"no link here - at all\n"
+
+	ticketStorage := storage.NewTicketStorage(nil)
+	preview, err := ticketStorage.PreviewImport(strings.NewReader(content), nil)
+	if err != nil {
+		t.Fatalf("PreviewImport failed: %v", err)
+	}
+
+	want := []struct{ url, title, format string }{
+		{"https://example.com/1", "First - with dash", "url - title"},
+		{"https://example.com/2", "Second", "title - url"},
+		{"https://example.com/3", "Third", `url\ttitle`},
+		{"https://example.com/4", "Fourth", "url | title"},
+	}
+	for i, w := range want {
+		c := preview.Candidates[i]
+		if c.URL != w.url || c.Title != w.title || c.Format != w.format {
+			t.Errorf("candidate %d = %+v, want %+v", i, c, w)
+		}
+	}
+	if preview.Candidates[4].Status != storage.ImportInvalid {
+		t.Errorf("expected line without a link to be invalid, got %+v", preview.Candidates[4])
+	}
+}
+
+func TestPreviewImport_BareURLs(t *testing.T) {
+	ticketStorage := &storage.TicketStorage{
+		Tickets: []storage.Ticket{{ID: 1, Title: "Existing", URL: "https://example.com/1"}},
+		NextID:  2,
+	}
+
+	text := "https://example.com/1\nhttps://example.com/2/\n\nhttps://example.com/3 - Third\n"
+	preview, err := ticketStorage.PreviewImport(strings.NewReader(text), nil)
+	if err != nil {
+		t.Fatalf("PreviewImport failed: %v", err)
+	}
+	if len(preview.Candidates) != 3 {
+		t.Fatalf("expected 3 candidates, got %d", len(preview.Candidates))
+	}
+	if preview.Candidates[0].Status != storage.ImportDuplicate {
+		t.Errorf("expected first link to be a duplicate, got %+v", preview.Candidates[0])
+	}
+	if c := preview.Candidates[1]; c.Title != "example.com/2" || c.Format != "url" {
+		t.Errorf("unexpected bare link candidate: %+v", c)
+	}
+	if c := preview.Candidates[2]; c.Title != "Third" || c.Format != "url - title" {
+		t.Errorf("unexpected titled candidate: %+v", c)
+	}
+}
@@ -0,0 +1,171 @@
+package unit
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"gotickets/internal/i18n"
+	"gotickets/internal/storage"
+	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
+)
+
+func TestI18n_LocalesHaveSameKeys(t *testing.T) {
+	reference := i18n.Keys(i18n.DefaultLanguage)
+	for _, lang := range i18n.Languages() {
+		keys := i18n.Keys(lang)
+		have := make(map[string]bool, len(keys))
+		for _, k := range keys {
+			have[k] = true
+		}
+		for _, k := range reference {
+			if !have[k] {
+				t.Errorf("key %q is missing in %q", k, lang)
+			}
+		}
+		if len(keys) != len(reference) {
+			t.Errorf("%q has %d keys, %q has %d", lang, len(keys), i18n.DefaultLanguage, len(reference))
+		}
+	}
+}
+
+var formatVerb = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func TestI18n_FormatVerbsMatch(t *testing.T) {
+	for _, key := range i18n.Keys(i18n.DefaultLanguage) {
+		base, _ := i18n.Message(i18n.DefaultLanguage, key)
+		want := formatVerb.FindAllString(base, -1)
+		for _, lang := range i18n.Languages() {
+			msg, ok := i18n.Message(lang, key)
+			if !ok {
+				continue
+			}
+			got := formatVerb.FindAllString(msg, -1)
+			if strings.Join(got, " ") != strings.Join(want, " ") {
+				t.Errorf("%s: %q has verbs %v, %q has %v", key, lang, got, i18n.DefaultLanguage, want)
+			}
+		}
+	}
+}
+
+var catalogKeyUse = regexp.MustCompile(`i18n\.(?:T|Error)\("([^"]+)"`)
+
+func TestI18n_UsedKeysExist(t *testing.T) {
+	var used []string
+	for _, root := range []string{"../../internal", "../../cmd"} {
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			for _, match := range catalogKeyUse.FindAllStringSubmatch(string(data), -1) {
+				used = append(used, match[1])
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(used) == 0 {
+		t.Fatal("no catalog keys found in the sources")
+	}
+	sort.Strings(used)
+	for _, key := range used {
+		for _, lang := range i18n.Languages() {
+			if _, ok := i18n.Message(lang, key); !ok {
+				t.Errorf("key %q used in the sources is missing in %q", key, lang)
+			}
+		}
+	}
+}
+
+func TestI18n_Detect(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(name string) string { return vars[name] }
+	}
+	cases := []struct {
+		name       string
+		configured string
+		vars       map[string]string
+		want       string
+		wantErr    bool
+	}{
+		{"default", "", nil, i18n.Russian, false},
+		{"lang", "", map[string]string{"LANG": "en_US.UTF-8"}, i18n.English, false},
+		{"lc_all wins", "", map[string]string{"LC_ALL": "ru_RU.UTF-8", "LANG": "en_US.UTF-8"}, i18n.Russian, false},
+		{"unsupported env", "", map[string]string{"LANG": "de_DE.UTF-8"}, i18n.Russian, false},
+		{"posix locale", "", map[string]string{"LANG": "C"}, i18n.Russian, false},
+		{"config wins", "EN", map[string]string{"LANG": "ru_RU.UTF-8"}, i18n.English, false},
+		{"unknown config", "fr", map[string]string{"LANG": "en_US"}, i18n.English, true},
+	}
+	for _, tc := range cases {
+		got, err := i18n.Detect(tc.configured, env(tc.vars))
+		if got != tc.want || (err != nil) != tc.wantErr {
+			t.Errorf("%s: got %q, %v", tc.name, got, err)
+		}
+	}
+}
+
+func TestI18n_StorageErrorsFollowLanguage(t *testing.T) {
+	defer i18n.SetLanguage(i18n.DefaultLanguage)
+
+	ru := storage.ErrDuplicateURL.Error()
+	if err := i18n.SetLanguage(i18n.English); err != nil {
+		t.Fatal(err)
+	}
+	en := storage.ErrDuplicateURL.Error()
+	if ru == en || strings.Contains(en, "storage.err") {
+		t.Errorf("expected a translated message, got %q and %q", ru, en)
+	}
+	wrapped := errors.Join(errors.New("context"), storage.ErrDuplicateURL)
+	if !errors.Is(wrapped, storage.ErrDuplicateURL) {
+		t.Error("errors.Is must still match localized sentinels")
+	}
+}
+
+func TestI18n_ModelUsesConfiguredLanguage(t *testing.T) {
+	defer i18n.SetLanguage(i18n.DefaultLanguage)
+
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	if err := mockFS.WriteFile(configPath, []byte(`{"language": "en"}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	model := gotickets.NewModelWithFS(mockFS)
+	model = sendKeys(t, model, runes("a"))
+	view := model.View()
+	if !strings.Contains(view, "Add a new ticket") {
+		t.Errorf("expected the English add view, got:\n%s", view)
+	}
+}
+
+func TestI18n_ConfigErrorInChosenLanguage(t *testing.T) {
+	defer i18n.SetLanguage(i18n.DefaultLanguage)
+	// A language left over from before must not leak into the config error
+	if err := i18n.SetLanguage(i18n.English); err != nil {
+		t.Fatal(err)
+	}
+
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	if err := mockFS.WriteFile(configPath, []byte(`{`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	model := gotickets.NewModelWithFS(mockFS)
+	if view := model.View(); !strings.Contains(view, "ошибка в config.json") {
+		t.Errorf("expected the config error in Russian, got:\n%s", view)
+	}
+}
@@ -0,0 +1,126 @@
+package unit
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotickets/internal/storage"
+	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestKeyMap_Presets(t *testing.T) {
+	for _, preset := range []string{"", "default", "vim", "emacs"} {
+		keys, err := gotickets.NewKeyMap(preset, nil)
+		if err != nil {
+			t.Fatalf("preset %q: unexpected error %v", preset, err)
+		}
+		if conflicts := keys.Conflicts(); len(conflicts) > 0 {
+			t.Fatalf("preset %q has conflicts: %v", preset, conflicts)
+		}
+	}
+
+	defaults, _ := gotickets.NewKeyMap("", nil)
+	if !key.Matches(runes("g"), defaults.Top) || key.Matches(runes("g"), defaults.Group) {
+		t.Fatal("expected g to go to the top of the list, not to group")
+	}
+	vim, _ := gotickets.NewKeyMap("vim", nil)
+	if !key.Matches(runes("z"), vim.Group) || !key.Matches(runes("g"), vim.Top) {
+		t.Fatal("expected vim preset to group on z and use g for the top")
+	}
+	emacs, _ := gotickets.NewKeyMap("emacs", nil)
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyCtrlN}, emacs.Down) {
+		t.Fatal("expected emacs preset to move down with ctrl+n")
+	}
+}
+
+func TestKeyMap_Overrides(t *testing.T) {
+	keys, err := gotickets.NewKeyMap("", map[string][]string{"add": {"n"}, "select": {"space"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !key.Matches(runes("n"), keys.Add) || key.Matches(runes("a"), keys.Add) {
+		t.Fatalf("expected add to be rebound to n, got %v", keys.Add.Keys())
+	}
+	if !key.Matches(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, keys.Select) {
+		t.Fatal("expected space to be accepted by name")
+	}
+
+	if _, err := gotickets.NewKeyMap("nano", map[string][]string{"fly": {"f"}}); err == nil ||
+		!strings.Contains(err.Error(), "nano") || !strings.Contains(err.Error(), "fly") {
+		t.Fatalf("expected unknown preset and action to be reported, got %v", err)
+	}
+}
+
+func TestKeyMap_ConflictsFallBackToPreset(t *testing.T) {
+	keys, err := gotickets.NewKeyMap("", map[string][]string{"pin": {"d"}, "sort": {"1"}})
+	if err == nil || !strings.Contains(err.Error(), "d: delete, pin") || !strings.Contains(err.Error(), "1: quick_filter, sort") {
+		t.Fatalf("expected conflicts to be reported, got %v", err)
+	}
+	if !key.Matches(runes("*"), keys.Pin) {
+		t.Fatalf("expected preset binding after a conflict, got %v", keys.Pin.Keys())
+	}
+
+	// The same key may be used by different contexts
+	if _, err := gotickets.NewKeyMap("", map[string][]string{"back": {"b"}}); err != nil {
+		t.Fatalf("expected picker and list bindings not to conflict, got %v", err)
+	}
+
+	// Keys the picker views handle themselves are taken as well
+	_, err = gotickets.NewKeyMap("", map[string][]string{"back": {"a"}})
+	if err == nil || !strings.Contains(err.Error(), "a: import_preview/bulk_actions, back") {
+		t.Fatalf("expected a conflict with the import preview and bulk actions, got %v", err)
+	}
+}
+
+func TestUI_KeysFromConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	data := `{"keys": {"add": ["n"], "back": ["esc", "x"]}}`
+	if err := mockFS.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	model := gotickets.NewModelWithFS(mockFS)
+	model = sendKeys(t, model, runes("n"))
+	if model.GetViewMode() != gotickets.ViewAddURL {
+		t.Fatalf("expected n to start adding a ticket, got view %v", model.GetViewMode())
+	}
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc}, runes("a"))
+	if model.GetViewMode() != gotickets.ViewList {
+		t.Fatalf("expected a to be unbound, got view %v", model.GetViewMode())
+	}
+
+	// q is no longer "back" in pickers once back is rebound
+	model = sendKeys(t, model, runes("f"), runes("q"))
+	if model.GetViewMode() != gotickets.ViewSavedSearches {
+		t.Fatalf("expected q to stay in the filter picker, got view %v", model.GetViewMode())
+	}
+	model = sendKeys(t, model, runes("x"))
+	if model.GetViewMode() != gotickets.ViewList {
+		t.Fatalf("expected x to go back, got view %v", model.GetViewMode())
+	}
+}
+
+func TestUI_KeyConflictWarning(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	if err := mockFS.WriteFile(configPath, []byte(`{"keys": {"open": ["a"]}}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	model := gotickets.NewModelWithFS(mockFS)
+	if view := model.View(); !strings.Contains(view, "a: add, open") {
+		t.Fatalf("expected conflict warning, got:\n%s", view)
+	}
+	model.SetStorage(storage.NewTicketStorage(mockFS))
+	model = sendKeys(t, model, runes("a"))
+	if model.GetViewMode() != gotickets.ViewAddURL {
+		t.Fatalf("expected preset binding to stay active, got view %v", model.GetViewMode())
+	}
+}
@@ -0,0 +1,15 @@
+package unit
+
+import (
+	"os"
+	"testing"
+)
+
+// TestMain pins the locale so assertions on Russian messages do not depend
+// on the environment the tests run in
+func TestMain(m *testing.M) {
+	os.Unsetenv("LC_ALL")
+	os.Unsetenv("LC_MESSAGES")
+	os.Setenv("LANG", "ru_RU.UTF-8")
+	os.Exit(m.Run())
+}
@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// screenPosition finds the column and row of text on the rendered screen
+func screenPosition(t *testing.T, model gotickets.Model, text string) (int, int) {
+	t.Helper()
+	for y, line := range strings.Split(model.View(), "\n") {
+		if i := strings.Index(line, text); i >= 0 {
+			return lipgloss.Width(line[:i]), y
+		}
+	}
+	t.Fatalf("%q is not on the screen:\n%s", text, model.View())
+	return 0, 0
+}
+
+func click(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+}
+
+func sendMouse(t *testing.T, model gotickets.Model, msgs ...tea.MouseMsg) gotickets.Model {
+	t.Helper()
+	for _, msg := range msgs {
+		updated, _ := model.Update(msg)
+		model = updated.(gotickets.Model)
+	}
+	return model
+}
+
+func TestMouse_ClickSelectsTicket(t *testing.T) {
+	model, _ := newTestModel(t, "First", "Second", "Third")
+
+	x, y := screenPosition(t, model, "Third")
+	model = sendMouse(t, model, click(x, y))
+	if got := model.State().SelectedID; got != 3 {
+		t.Fatalf("expected the clicked ticket to be selected, got %d", got)
+	}
+
+	model = sendMouse(t, model, tea.MouseMsg{Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress})
+	if got := model.State().SelectedID; got != 2 {
+		t.Fatalf("expected the wheel to move the cursor up, got %d", got)
+	}
+
+	// Clicks below the last ticket change nothing
+	model = sendMouse(t, model, click(x, y+5))
+	if got := model.State().SelectedID; got != 2 {
+		t.Fatalf("expected a click on empty space to be ignored, got %d", got)
+	}
+}
+
+func TestMouse_DoubleClickTogglesGroup(t *testing.T) {
+	model, _ := newTestModel(t, "First", "Second", "Third")
+	model = sendKeys(t, model, runes("z"))
+
+	x, y := screenPosition(t, model, "example.com")
+	model = sendMouse(t, model, click(x, y), click(x, y))
+	if strings.Contains(model.View(), "Second") {
+		t.Fatalf("expected a double click to collapse the group, got:\n%s", model.View())
+	}
+}
+
+func TestMouse_DialogAnswers(t *testing.T) {
+	model, _ := newTestModel(t, "First", "Second", "Third")
+	model = sendKeys(t, model, runes("d"))
+
+	x, y := screenPosition(t, model, "нет, отменить")
+	model = sendMouse(t, model, click(x, y))
+	if model.GetViewMode() != gotickets.ViewList || len(model.GetStorage().Tickets) != 3 {
+		t.Fatalf("expected the deletion to be cancelled, got view %v", model.GetViewMode())
+	}
+
+	model = sendKeys(t, model, runes("d"))
+	x, y = screenPosition(t, model, "да, удалить")
+	model = sendMouse(t, model, click(x, y))
+	if model.GetViewMode() != gotickets.ViewList || len(model.GetStorage().Tickets) != 2 {
+		t.Fatalf("expected the ticket to be deleted, got %d tickets", len(model.GetStorage().Tickets))
+	}
+}
+
+func TestConfig_MouseSwitch(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	if !gotickets.NewModelWithFS(mockFS).MouseEnabled() {
+		t.Fatal("expected the mouse to be enabled by default")
+	}
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	if err := mockFS.WriteFile(configPath, []byte(`{"mouse": false}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if gotickets.NewModelWithFS(mockFS).MouseEnabled() {
+		t.Fatal("expected \"mouse\": false to disable the mouse")
+	}
+}
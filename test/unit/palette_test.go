@@ -0,0 +1,96 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"gotickets/pkg/gotickets"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUI_HelpOverlayListsBindings(t *testing.T) {
+	model, _ := newTestModel(t, "First")
+
+	model = sendKeys(t, model, runes("?"))
+	if model.GetViewMode() != gotickets.ViewHelp {
+		t.Fatalf("expected help overlay, got view %v", model.GetViewMode())
+	}
+	view := model.View()
+	if !strings.Contains(view, "Справка по клавишам") || !strings.Contains(view, "Навигация") {
+		t.Fatalf("expected navigation bindings first, got:\n%s", view)
+	}
+
+	// The list bindings do not fit on one screen, the end of the overlay shows the rest
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEnd})
+	view = model.View()
+	for _, want := range []string{":/ctrl+p", "ctrl+c"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q at the end of the help overlay, got:\n%s", want, view)
+		}
+	}
+
+	model = sendKeys(t, model, runes("?"))
+	if model.GetViewMode() != gotickets.ViewList {
+		t.Fatalf("expected ? to close the overlay, got view %v", model.GetViewMode())
+	}
+}
+
+func TestUI_HelpOverlayForPicker(t *testing.T) {
+	model, _ := newTestModel(t, "First")
+
+	model = sendKeys(t, model, runes("f"), runes("?"))
+	view := model.View()
+	if !strings.Contains(view, "Сохраненные фильтры") || strings.Contains(view, "Навигация") {
+		t.Fatalf("expected saved filter bindings only, got:\n%s", view)
+	}
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc})
+	if model.GetViewMode() != gotickets.ViewSavedSearches {
+		t.Fatalf("expected to return to the picker, got view %v", model.GetViewMode())
+	}
+}
+
+func TestUI_PaletteRunsFuzzyMatchedCommand(t *testing.T) {
+	model, _ := newTestModel(t, "First")
+
+	model = sendKeys(t, model, runes(":"))
+	if model.GetViewMode() != gotickets.ViewPalette {
+		t.Fatalf("expected command palette, got view %v", model.GetViewMode())
+	}
+	if !strings.Contains(model.View(), "Импорт из файла") {
+		t.Fatalf("expected every command with an empty query, got:\n%s", model.View())
+	}
+
+	model = sendKeys(t, model, runes("импрт"), tea.KeyMsg{Type: tea.KeyEnter})
+	if model.GetViewMode() != gotickets.ViewImport {
+		t.Fatalf("expected the import command to run, got view %v", model.GetViewMode())
+	}
+}
+
+func TestUI_PaletteNoMatches(t *testing.T) {
+	model, _ := newTestModel(t, "First")
+
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyCtrlP}, runes("zzzz"), tea.KeyMsg{Type: tea.KeyEnter})
+	if model.GetViewMode() != gotickets.ViewPalette || !strings.Contains(model.View(), "Нет подходящих команд") {
+		t.Fatalf("expected the palette to stay open without matches, got:\n%s", model.View())
+	}
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc})
+	if model.GetViewMode() != gotickets.ViewList {
+		t.Fatalf("expected Esc to close the palette, got view %v", model.GetViewMode())
+	}
+}
+
+func TestUI_PaletteSwitchesThemeAndSort(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	model, _ := newTestModel(t, "First")
+
+	model = sendKeys(t, model, runes(":"), runes("тема light"), tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(model.View(), "Тема: light") {
+		t.Fatalf("expected theme switch notice, got:\n%s", model.View())
+	}
+
+	model = sendKeys(t, model, runes(":"), runes("сортировка по названию"), tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(model.View(), "по названию") {
+		t.Fatalf("expected sort by title, got:\n%s", model.View())
+	}
+}
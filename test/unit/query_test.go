@@ -0,0 +1,120 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"gotickets/internal/storage"
+)
+
+func queryStorage() *storage.TicketStorage {
+	return &storage.TicketStorage{
+		Tickets: []storage.Ticket{
+			{ID: 1, Title: "Fix login bug", URL: "https://github.com/org/repo/issues/10",
+				CreatedAt: time.Date(2025, 2, 3, 10, 0, 0, 0, time.Local)},
+			{ID: 2, Title: "WIP login bug follow-up", URL: "https://api.github.com/org/repo/issues/11",
+				Tags: []string{"wip"}, CreatedAt: time.Date(2025, 3, 1, 9, 0, 0, 0, time.Local)},
+			{ID: 3, Title: "Bug in login page", URL: "https://jira.local/browse/PROJ-42",
+				Key: "PROJ-42", Tags: []string{"urgent"}, Status: "open",
+				CreatedAt: time.Date(2024, 12, 31, 23, 0, 0, 0, time.Local)},
+		},
+		NextID: 4,
+	}
+}
+
+func queryIDs(t *testing.T, ts *storage.TicketStorage, query string) []int {
+	t.Helper()
+	tickets, err := ts.Query(query)
+	if err != nil {
+		t.Fatalf("Query(%q) returned error: %v", query, err)
+	}
+	ids := make([]int, len(tickets))
+	for i, ticket := range tickets {
+		ids[i] = ticket.ID
+	}
+	return ids
+}
+
+func TestQuery_Fields(t *testing.T) {
+	ts := queryStorage()
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{`host:github.com`, []int{1, 2}},
+		{`host:github.com created:>2025-02-03 -wip`, nil},
+		{`host:github.com created:>=2025-02-03 -tag:wip`, []int{1}},
+		{`"login bug"`, []int{1, 2}},
+		{`title:"login page"`, []int{3}},
+		{`num:PROJ-42`, []int{3}},
+		{`id:2`, []int{2}},
+		{`status:OPEN`, []int{3}},
+		{`created:<2025-01`, []int{3}},
+		{`created:2025-03`, []int{2}},
+		{`tag:urgent OR tag:wip`, []int{2, 3}},
+		{`https://jira.local/browse/PROJ-42 -github`, []int{3}},
+		{`-"wip"`, []int{1, 3}},
+		{`"-wip"`, nil},
+		{`"login: bug"`, nil},
+		{`"proj-42" -owner:me`, []int{3}},
+	}
+	for _, tt := range tests {
+		got := queryIDs(t, ts, tt.query)
+		if len(got) != len(tt.want) {
+			t.Errorf("Query(%q) = %v, want %v", tt.query, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("Query(%q) = %v, want %v", tt.query, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestQuery_PlainTextUsesFuzzySearch(t *testing.T) {
+	q, err := storage.ParseQuery("login bug")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !q.IsPlain() || q.PlainText() != "login bug" {
+		t.Fatalf("expected plain query, got %+v", q)
+	}
+	for _, query := range []string{`"login bug"`, "-wip", "host:github.com", "a OR b"} {
+		q, err := storage.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("ParseQuery(%q) returned error: %v", query, err)
+		}
+		if q.IsPlain() {
+			t.Errorf("ParseQuery(%q) should not be plain", query)
+		}
+	}
+}
+
+func TestParseQuery_UnknownPrefixIsText(t *testing.T) {
+	for _, query := range []string{"12:30", "re: login", "owner:me"} {
+		q, err := storage.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("ParseQuery(%q) returned error: %v", query, err)
+		}
+		if !q.IsPlain() || q.PlainText() != query {
+			t.Errorf("ParseQuery(%q) should be plain text, got %+v", query, q)
+		}
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	for _, query := range []string{
+		`"login bug`,
+		`title:`,
+		`id:abc`,
+		`created:>yesterday`,
+		`OR tag:wip`,
+		`tag:wip OR`,
+	} {
+		if _, err := storage.ParseQuery(query); err == nil {
+			t.Errorf("ParseQuery(%q) expected error", query)
+		}
+	}
+}
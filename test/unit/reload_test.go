@@ -0,0 +1,199 @@
+package unit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gotickets/internal/storage"
+	"gotickets/internal/watch"
+	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newReloadTestModel returns a model with two saved tickets, the second one selected
+func newReloadTestModel(t *testing.T) (gotickets.Model, *mocks.MockFileSystem) {
+	t.Helper()
+	model, mockFS := newTestModel(t, "First", "Second")
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyDown})
+	return model, mockFS
+}
+
+// changeOnDisk adds a ticket to tickets.json the way another instance would
+func changeOnDisk(t *testing.T, mockFS *mocks.MockFileSystem, title string) {
+	t.Helper()
+	other, _ := storage.LoadTicketsWithFS(mockFS)
+	url := "https://example.com/" + strings.ReplaceAll(strings.ToLower(title), " ", "-")
+	if _, err := other.AddTicket(title, url); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Save(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func sendTicketsChanged(t *testing.T, model gotickets.Model) gotickets.Model {
+	t.Helper()
+	updated, _ := model.Update(gotickets.TicketsChangedMsg{})
+	return updated.(gotickets.Model)
+}
+
+func TestReload_ExternalChangeKeepsSelection(t *testing.T) {
+	model, mockFS := newReloadTestModel(t)
+	changeOnDisk(t, mockFS, "Third")
+
+	model = sendTicketsChanged(t, model)
+
+	if got := len(model.GetStorage().Tickets); got != 3 {
+		t.Fatalf("expected the ticket added on disk to be loaded, got %d tickets", got)
+	}
+	if got := model.State().SelectedID; got != 2 {
+		t.Fatalf("expected the selected ticket to stay selected, got ID %d", got)
+	}
+	if !strings.Contains(model.View(), "Тикеты обновлены") {
+		t.Fatalf("expected a reload notification, got:\n%s", model.View())
+	}
+}
+
+func TestReload_OwnSaveIsIgnored(t *testing.T) {
+	model, _ := newReloadTestModel(t)
+
+	model = sendTicketsChanged(t, model)
+
+	if strings.Contains(model.View(), "Тикеты обновлены") {
+		t.Fatalf("expected our own save not to trigger a reload, got:\n%s", model.View())
+	}
+}
+
+func TestReload_PartiallyWrittenFileIsIgnored(t *testing.T) {
+	model, mockFS := newReloadTestModel(t)
+	path, _ := storage.TicketsFile(mockFS)
+	if err := mockFS.WriteFile(path, []byte(`{"tickets": [`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	model = sendTicketsChanged(t, model)
+
+	if got := len(model.GetStorage().Tickets); got != 2 {
+		t.Fatalf("expected tickets to stay until the file is complete, got %d", got)
+	}
+}
+
+func TestReload_FileThatIsNotTicketsKeepsTickets(t *testing.T) {
+	for _, content := range []string{`{"tickets": "x"}`, `[]`} {
+		t.Run(content, func(t *testing.T) {
+			model, mockFS := newReloadTestModel(t)
+			path, _ := storage.TicketsFile(mockFS)
+			if err := mockFS.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			model = sendTicketsChanged(t, model)
+
+			if got := len(model.GetStorage().Tickets); got != 2 {
+				t.Fatalf("expected the tickets in memory to be kept, got %d", got)
+			}
+			if !strings.Contains(model.View(), "тикеты в памяти сохранены") {
+				t.Fatalf("expected a warning, got:\n%s", model.View())
+			}
+		})
+	}
+}
+
+func TestReload_UnsavedChangesAsk(t *testing.T) {
+	for _, tc := range []struct {
+		answer  string
+		tickets []string
+	}{
+		{answer: "y", tickets: []string{"First", "Second", "On disk"}},
+		{answer: "n", tickets: []string{"First", "Second", "In memory"}},
+	} {
+		t.Run(tc.answer, func(t *testing.T) {
+			model, mockFS := newReloadTestModel(t)
+			// Backups still work, only saving tickets.json fails
+			mockFS.SetError("MkdirAll", mocks.AssertErr("disk full"))
+			model = sendKeys(t, model,
+				runes("a"), runes("https://example.com/memory"), tea.KeyMsg{Type: tea.KeyEnter},
+				runes("In memory"), tea.KeyMsg{Type: tea.KeyEnter},
+			)
+			mockFS.ClearError("MkdirAll")
+			changeOnDisk(t, mockFS, "On disk")
+
+			model = sendTicketsChanged(t, model)
+			if model.GetViewMode() != gotickets.ViewConfirmReload {
+				t.Fatalf("expected the reload prompt, got view %v", model.GetViewMode())
+			}
+
+			model = sendKeys(t, model, runes(tc.answer))
+			if model.GetViewMode() != gotickets.ViewList {
+				t.Fatalf("expected to return to the list, got view %v", model.GetViewMode())
+			}
+			var titles []string
+			for _, ticket := range model.GetStorage().Tickets {
+				titles = append(titles, ticket.Title)
+			}
+			if strings.Join(titles, ",") != strings.Join(tc.tickets, ",") {
+				t.Fatalf("expected tickets %v, got %v", tc.tickets, titles)
+			}
+		})
+	}
+}
+
+// waitForChange fails the test unless the watcher reports a change in time
+func waitForChange(t *testing.T, w *watch.Watcher) {
+	t.Helper()
+	select {
+	case <-w.Changes():
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected the watcher to report the change")
+	}
+}
+
+func TestWatch_ReportsWriteAndReplace(t *testing.T) {
+	for name, newWatcher := range map[string]func(string) *watch.Watcher{
+		"native":  watch.New,
+		"polling": func(path string) *watch.Watcher { return watch.NewPolling(path, 20*time.Millisecond) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "tickets.json")
+			if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			w := newWatcher(path)
+			defer w.Close()
+
+			if err := os.WriteFile(path, []byte(`{"tickets": []}`), 0644); err != nil {
+				t.Fatal(err)
+			}
+			waitForChange(t, w)
+
+			// Editors and scripts often write a temporary file and rename it
+			tmp := filepath.Join(dir, "tickets.json.tmp")
+			if err := os.WriteFile(tmp, []byte(`{"tickets": null}`), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Rename(tmp, path); err != nil {
+				t.Fatal(err)
+			}
+			waitForChange(t, w)
+		})
+	}
+}
+
+func TestWatch_CloseEndsChanges(t *testing.T) {
+	w := watch.New(filepath.Join(t.TempDir(), "tickets.json"))
+	w.Close()
+	select {
+	case _, ok := <-w.Changes():
+		if ok {
+			t.Fatal("expected no change to be reported")
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected Close to close the changes channel")
+	}
+}
@@ -0,0 +1,98 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"gotickets/internal/storage"
+	"gotickets/test/mocks"
+)
+
+func searchStorage() *storage.TicketStorage {
+	return &storage.TicketStorage{
+		Tickets: []storage.Ticket{
+			{ID: 1, Title: "Update prefix handling", URL: "https://example.com/a"},
+			{ID: 2, Title: "Fix login", URL: "https://example.com/b"},
+			{ID: 3, Title: "Documentation", URL: "https://github.com/user/repo/issues/4711"},
+		},
+		NextID: 4,
+	}
+}
+
+func TestSearch_FuzzyRanking(t *testing.T) {
+	ticketStorage := searchStorage()
+
+	results := ticketStorage.Search("fix")
+	if len(results) != 2 || results[0].ID != 2 || results[1].ID != 1 {
+		t.Fatalf("expected 'Fix login' to rank above 'Update prefix handling', got %+v", results)
+	}
+
+	// Characters do not have to be adjacent
+	results = ticketStorage.Search("fxlgn")
+	if len(results) != 1 || results[0].ID != 2 {
+		t.Fatalf("expected fuzzy match for 'fxlgn', got %+v", results)
+	}
+
+	if results := ticketStorage.Search("zzz"); len(results) != 0 {
+		t.Fatalf("expected no results, got %+v", results)
+	}
+}
+
+func TestSearchMatches_Fields(t *testing.T) {
+	ticketStorage := searchStorage()
+
+	matches := ticketStorage.SearchMatches("4711")
+	if len(matches) != 1 || matches[0].Ticket.ID != 3 || matches[0].Field != storage.FieldNumber {
+		t.Fatalf("expected number match for ticket 3, got %+v", matches)
+	}
+
+	matches = ticketStorage.SearchMatches("login")
+	if len(matches) != 1 || matches[0].Field != storage.FieldTitle {
+		t.Fatalf("expected title match, got %+v", matches)
+	}
+	want := []int{4, 5, 6, 7, 8}
+	for i, idx := range want {
+		if matches[0].MatchedIndexes[i] != idx {
+			t.Fatalf("MatchedIndexes = %v, want %v", matches[0].MatchedIndexes, want)
+		}
+	}
+
+	matches = ticketStorage.SearchMatches("github")
+	if len(matches) != 1 || matches[0].Field != storage.FieldURL {
+		t.Fatalf("expected URL match, got %+v", matches)
+	}
+}
+
+func TestSaveSearch_ReplacesByNameAndPersists(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+
+	if err := ticketStorage.SaveSearch("GitHub", "host:github.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ticketStorage.SaveSearch("github", "host:github.com -wip"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ticketStorage.SavedSearches) != 1 || ticketStorage.SavedSearches[0].Query != "host:github.com -wip" {
+		t.Fatalf("expected the saved search to be replaced, got %+v", ticketStorage.SavedSearches)
+	}
+
+	if err := ticketStorage.SaveSearch(" ", "login"); !errors.Is(err, storage.ErrEmptyName) {
+		t.Fatalf("expected ErrEmptyName, got %v", err)
+	}
+	if err := ticketStorage.SaveSearch("broken", "id:abc"); err == nil {
+		t.Fatal("expected an invalid query to be rejected")
+	}
+
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save: %v", err)
+	}
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if _, ok := loaded.FindSavedSearch("GITHUB"); !ok {
+		t.Fatalf("expected saved search to be loaded, got %+v", loaded.SavedSearches)
+	}
+
+	if err := loaded.DeleteSavedSearch("missing"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
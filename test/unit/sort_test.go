@@ -0,0 +1,127 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"gotickets/internal/storage"
+)
+
+func sortedIDs(tickets []storage.Ticket) []int {
+	ids := make([]int, len(tickets))
+	for i, ticket := range tickets {
+		ids[i] = ticket.ID
+	}
+	return ids
+}
+
+func TestSortTickets_Modes(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	opened := day(20)
+	tickets := []storage.Ticket{
+		{ID: 1, Title: "beta", URL: "https://github.com/o/r/issues/10", CreatedAt: day(2), Status: "open"},
+		{ID: 2, Title: "Alpha", URL: "https://example.com/task/9", CreatedAt: day(3)},
+		{ID: 3, Title: "gamma", URL: "https://api.example.com/x/100", CreatedAt: day(1), Status: "done", LastOpenedAt: &opened},
+	}
+
+	tests := []struct {
+		mode storage.SortMode
+		want []int
+	}{
+		{storage.SortAdded, []int{1, 2, 3}},
+		{storage.SortCreatedAsc, []int{3, 1, 2}},
+		{storage.SortCreatedDesc, []int{2, 1, 3}},
+		{storage.SortTitle, []int{2, 1, 3}},
+		{storage.SortNumber, []int{2, 1, 3}},
+		{storage.SortHost, []int{3, 2, 1}},
+		{storage.SortLastOpened, []int{3, 1, 2}},
+		{storage.SortStatus, []int{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		got := sortedIDs(storage.SortTickets(tickets, tt.mode))
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("SortTickets(%s) = %v, want %v", tt.mode, got, tt.want)
+				break
+			}
+		}
+	}
+	if tickets[0].ID != 1 {
+		t.Fatal("SortTickets must not reorder its input")
+	}
+}
+
+func TestParseSortMode(t *testing.T) {
+	if mode, err := storage.ParseSortMode(""); err != nil || mode != storage.SortAdded {
+		t.Fatalf("expected default sort mode, got %q, %v", mode, err)
+	}
+	if mode, err := storage.ParseSortMode("Title"); err != nil || mode != storage.SortTitle {
+		t.Fatalf("expected title sort mode, got %q, %v", mode, err)
+	}
+	if _, err := storage.ParseSortMode("size"); err == nil {
+		t.Fatal("expected an error for an unknown sort mode")
+	}
+	if label := storage.SortMode("size").Label(); label != storage.SortAdded.Label() {
+		t.Fatalf("expected an unknown sort mode to be labelled as the default, got %q", label)
+	}
+	if storage.SortModes[len(storage.SortModes)-1].Next() != storage.SortModes[0] {
+		t.Fatal("expected sort modes to cycle")
+	}
+}
+
+func TestGroupTickets_ByHostAndProject(t *testing.T) {
+	tickets := []storage.Ticket{
+		{ID: 1, Title: "a", URL: "https://jira.local/browse/PROJ-1", Key: "PROJ-1"},
+		{ID: 2, Title: "b", URL: "https://github.com/o/r/issues/2"},
+		{ID: 3, Title: "c", URL: "https://jira.local/browse/OPS-7"},
+		{ID: 4, Title: "d", URL: "https://jira.local/browse/PROJ-2"},
+	}
+
+	groups := storage.GroupTickets(tickets, storage.GroupHost)
+	if len(groups) != 2 || groups[0].Name != "github.com" || groups[1].Name != "jira.local" || len(groups[1].Tickets) != 3 {
+		t.Fatalf("unexpected host groups: %+v", groups)
+	}
+
+	groups = storage.GroupTickets(tickets, storage.GroupProject)
+	names := make([]string, len(groups))
+	for i, group := range groups {
+		names[i] = group.Name
+	}
+	if len(groups) != 3 || names[0] != "OPS" || names[1] != "PROJ" || names[2] != "" {
+		t.Fatalf("expected OPS, PROJ and an ungrouped tail, got %q", names)
+	}
+	if ids := sortedIDs(groups[1].Tickets); ids[0] != 1 || ids[1] != 4 {
+		t.Fatalf("expected ticket order to be kept within a group, got %v", ids)
+	}
+}
+
+func TestUsage_RecentAndMRUSort(t *testing.T) {
+	ticketStorage := storage.NewTicketStorage(nil)
+	ticketStorage.Tickets = []storage.Ticket{
+		{ID: 1, Title: "never used", URL: "https://example.com/1"},
+		{ID: 2, Title: "opened", URL: "https://example.com/2"},
+		{ID: 3, Title: "copied", URL: "https://example.com/3"},
+	}
+	if err := ticketStorage.MarkOpened(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ticketStorage.MarkOpened(2)
+	time.Sleep(time.Millisecond)
+	ticketStorage.MarkCopied(3)
+
+	opened := ticketStorage.TicketsByID([]int{2})[0]
+	if opened.OpenCount != 2 || opened.LastOpenedAt == nil || opened.CopyCount != 0 {
+		t.Fatalf("unexpected usage for opened ticket: %+v", opened)
+	}
+
+	recent := ticketStorage.RecentTickets(0)
+	if ids := sortedIDs(recent); len(ids) != 2 || ids[0] != 3 || ids[1] != 2 {
+		t.Fatalf("expected copied then opened ticket, got %v", ids)
+	}
+	if ids := sortedIDs(storage.SortTickets(ticketStorage.Tickets, storage.SortRecent)); ids[0] != 3 || ids[2] != 1 {
+		t.Fatalf("expected MRU order with unused tickets last, got %v", ids)
+	}
+	if err := ticketStorage.MarkCopied(99); err == nil {
+		t.Fatal("expected an error for an unknown ticket")
+	}
+}
@@ -1,6 +1,8 @@
 package unit
 
 import (
+	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -70,8 +72,8 @@ func TestTicketStorage_DeleteTicket(t *testing.T) {
 	ticketStorage.AddTicket("Test 2", "https://example.com/2")
 
 	// Delete first ticket (ID 1)
-	if !ticketStorage.DeleteTicket(1) {
-		t.Fatal("Expected successful deletion of ticket ID 1")
+	if err := ticketStorage.DeleteTicket(1); err != nil {
+		t.Fatalf("Expected successful deletion of ticket ID 1, got %v", err)
 	}
 
 	if len(ticketStorage.Tickets) != 1 {
@@ -83,8 +85,8 @@ func TestTicketStorage_DeleteTicket(t *testing.T) {
 	}
 
 	// Try to delete non-existent ticket
-	if ticketStorage.DeleteTicket(999) {
-		t.Fatal("Expected deletion of non-existent ticket to fail")
+	if err := ticketStorage.DeleteTicket(999); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound for non-existent ticket, got %v", err)
 	}
 }
 
@@ -141,6 +143,57 @@ func TestTicket_ExtractTicketNumber(t *testing.T) {
 	}
 }
 
+func TestTicketStorage_AddTicket_Validation(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticket, err := ticketStorage.AddTicket("  First  ", " https://example.com/1 ")
+	if err != nil {
+		t.Fatalf("AddTicket failed: %v", err)
+	}
+	if ticket.ID != 1 || ticket.Title != "First" || ticket.URL != "https://example.com/1" {
+		t.Fatalf("unexpected created ticket: %+v", ticket)
+	}
+
+	testCases := []struct {
+		title, url string
+		want       error
+	}{
+		{"Dup", "https://example.com/1", storage.ErrDuplicateURL},
+		{"", "https://example.com/2", storage.ErrEmptyTitle},
+		{"No URL", "   ", storage.ErrInvalidURL},
+		{"Spaces", "not a url", storage.ErrInvalidURL},
+	}
+	for _, tc := range testCases {
+		if _, err := ticketStorage.AddTicket(tc.title, tc.url); !errors.Is(err, tc.want) {
+			t.Errorf("AddTicket(%q, %q) error = %v, want %v", tc.title, tc.url, err, tc.want)
+		}
+	}
+	if len(ticketStorage.Tickets) != 1 {
+		t.Fatalf("expected invalid tickets not to be added, got %d", len(ticketStorage.Tickets))
+	}
+}
+
+func TestTicketStorage_AddTicket_BackupFailure(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Existing", "https://example.com/1")
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("Failed to save storage: %v", err)
+	}
+
+	mockFS.SetError("ReadFile", mocks.AssertErr("read failure"))
+	if _, err := ticketStorage.AddTicket("New", "https://example.com/2"); !errors.Is(err, storage.ErrBackupFailed) {
+		t.Fatalf("expected AddTicket to return ErrBackupFailed, got %v", err)
+	}
+	if len(ticketStorage.Tickets) != 1 {
+		t.Fatalf("expected ticket not to be added when backup fails, got %d tickets", len(ticketStorage.Tickets))
+	}
+}
+
 func TestCreateBackup_NoExistingTicketsFile(t *testing.T) {
 	tempDir := t.TempDir()
 	mockFS := mocks.NewMockFileSystem(tempDir)
@@ -165,7 +218,7 @@ func TestCreateBackup_ReadFileError(t *testing.T) {
 	// Force ReadFile error
 	mockFS.SetError("ReadFile", mocks.AssertErr("read failure"))
 
-	if err := storage.CreateBackupUsing(mockFS); err == nil || !strings.Contains(err.Error(), "failed to read tickets file for backup") {
+	if err := storage.CreateBackupUsing(mockFS); err == nil || !strings.Contains(err.Error(), "не удалось прочитать tickets.json для резервной копии") {
 		t.Fatalf("expected read error from createBackup, got: %v", err)
 	}
 }
@@ -239,3 +292,174 @@ func TestStorage_HasTicketWithURL_DuplicateDetection(t *testing.T) {
 		t.Fatalf("expected ticket storage to not find non-existent URL")
 	}
 }
+
+func TestStorage_PreviewImportFile_MarksDuplicatesAndInvalid(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	importPath := filepath.Join(tempDir, "import.txt")
+	content := "https://example.com/1 - First\n" +
+		"not a valid line\n" +
+		"https://example.com/existing - Already there\n" +
+		"https://example.com/1 - First again\n"
+	if err := mockFS.WriteFile(importPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Existing", "https://example.com/existing")
+
+	preview, err := ticketStorage.PreviewImportFile(importPath, nil)
+	if err != nil {
+		t.Fatalf("PreviewImportFile failed: %v", err)
+	}
+	if len(ticketStorage.Tickets) != 1 {
+		t.Fatalf("preview must not modify storage, got %d tickets", len(ticketStorage.Tickets))
+	}
+
+	want := []storage.ImportStatus{storage.ImportNew, storage.ImportInvalid, storage.ImportDuplicate, storage.ImportDuplicate}
+	if len(preview.Candidates) != len(want) {
+		t.Fatalf("expected %d candidates, got %d", len(want), len(preview.Candidates))
+	}
+	for i, status := range want {
+		if preview.Candidates[i].Status != status {
+			t.Errorf("candidate %d: status = %v, want %v", i, preview.Candidates[i].Status, status)
+		}
+	}
+
+	// Invalid lines cannot be selected
+	preview.Toggle(1)
+	if preview.Candidates[1].Selected {
+		t.Fatal("expected invalid candidate to stay unselected")
+	}
+}
+
+func TestStorage_ApplyImport_OnlySelected(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	importPath := filepath.Join(tempDir, "import.txt")
+	content := "https://example.com/1 - One\nhttps://example.com/2 - Two\n"
+	if err := mockFS.WriteFile(importPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	preview, err := ticketStorage.PreviewImportFile(importPath, nil)
+	if err != nil {
+		t.Fatalf("PreviewImportFile failed: %v", err)
+	}
+	preview.Toggle(0)
+	preview.SetTitle(1, "  Renamed  ")
+
+	result, err := ticketStorage.ApplyImport(preview)
+	if err != nil {
+		t.Fatalf("ApplyImport failed: %v", err)
+	}
+	if result.Added != 1 || result.Skipped != 1 {
+		t.Fatalf("unexpected importResult: %+v", result)
+	}
+	if len(ticketStorage.Tickets) != 1 || ticketStorage.Tickets[0].Title != "Renamed" {
+		t.Fatalf("unexpected tickets after import: %+v", ticketStorage.Tickets)
+	}
+}
+
+func TestStorage_ApplyImport_RollsBackWhenSaveFails(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	importPath := filepath.Join(tempDir, "import.txt")
+	content := "https://example.com/1 - One\nhttps://example.com/2 - Two\n"
+	if err := mockFS.WriteFile(importPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	preview, err := ticketStorage.PreviewImportFile(importPath, nil)
+	if err != nil {
+		t.Fatalf("PreviewImportFile failed: %v", err)
+	}
+
+	mockFS.SetError("WriteFile", mocks.AssertErr("disk full"))
+	result, err := ticketStorage.ApplyImport(preview)
+	if err == nil {
+		t.Fatal("expected ApplyImport to fail when saving fails")
+	}
+	if result.Added != 0 || len(ticketStorage.Tickets) != 0 || ticketStorage.NextID != 1 {
+		t.Fatalf("expected no changes after failed import, got result %+v and %d tickets", result, len(ticketStorage.Tickets))
+	}
+}
+
+func TestStorage_ApplyImport_AbortsWhenBackupFails(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Existing", "https://example.com/existing")
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save storage: %v", err)
+	}
+
+	importPath := filepath.Join(tempDir, "import.txt")
+	if err := mockFS.WriteFile(importPath, []byte("https://example.com/1 - One\n"), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+	preview, err := ticketStorage.PreviewImportFile(importPath, nil)
+	if err != nil {
+		t.Fatalf("PreviewImportFile failed: %v", err)
+	}
+
+	mockFS.SetError("ReadFile", mocks.AssertErr("read failure"))
+	if _, err := ticketStorage.ApplyImport(preview); err == nil {
+		t.Fatal("expected ApplyImport to fail when backup fails")
+	}
+	if len(ticketStorage.Tickets) != 1 {
+		t.Fatalf("expected storage to be unchanged, got %d tickets", len(ticketStorage.Tickets))
+	}
+}
+
+func TestStorage_ImportFromFile_LargeFile(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	var content strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&content, "https://example.com/%d - Ticket %d\n", i, i)
+	}
+	importPath := filepath.Join(tempDir, "import.txt")
+	if err := mockFS.WriteFile(importPath, []byte(content.String()), 0644); err != nil {
+		t.Fatalf("failed to write import file: %v", err)
+	}
+
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	result, err := ticketStorage.ImportFromFile(importPath)
+	if err != nil {
+		t.Fatalf("ImportFromFile failed: %v", err)
+	}
+	if result.Added != 1000 || ticketStorage.NextID != 1001 {
+		t.Fatalf("unexpected importResult: %+v (NextID %d)", result, ticketStorage.NextID)
+	}
+
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(loaded.Tickets) != 1000 {
+		t.Fatalf("expected import to be saved, got %d tickets on disk", len(loaded.Tickets))
+	}
+}
+
+func TestLoadState_MissingAndMalformed(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+
+	state, err := storage.LoadState(mockFS)
+	if err != nil || state.Query != "" || state.SelectedID != 0 {
+		t.Fatalf("expected empty state for a missing file, got %+v, %v", state, err)
+	}
+
+	statePath := filepath.Join(tempDir, ".gotickets", storage.StateFileName)
+	if err := mockFS.WriteFile(statePath, []byte("{broken"), 0644); err != nil {
+		t.Fatalf("failed to write state: %v", err)
+	}
+	if _, err := storage.LoadState(mockFS); err == nil {
+		t.Fatal("expected an error for a malformed state file")
+	}
+}
@@ -0,0 +1,62 @@
+package unit
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTheme_Resolve(t *testing.T) {
+	theme, err := gotickets.ResolveTheme("", false)
+	if err != nil || theme.Name != "dark" {
+		t.Fatalf("expected dark theme by default, got %q (%v)", theme.Name, err)
+	}
+	theme, err = gotickets.ResolveTheme("Light", false)
+	if err != nil || theme.Name != "light" {
+		t.Fatalf("expected light theme, got %q (%v)", theme.Name, err)
+	}
+	theme, err = gotickets.ResolveTheme("solarized", false)
+	if err == nil || theme.Name != "dark" {
+		t.Fatalf("expected unknown theme to fall back to dark with an error, got %q (%v)", theme.Name, err)
+	}
+
+	// NO_COLOR wins over the configured theme
+	theme, err = gotickets.ResolveTheme("high-contrast", true)
+	if err != nil || !theme.NoColor {
+		t.Fatalf("expected no-color theme, got %q (%v)", theme.Name, err)
+	}
+}
+
+func TestTheme_NoColorStyles(t *testing.T) {
+	theme, _ := gotickets.ResolveTheme("no-color", false)
+	styles := gotickets.NewStyles(theme)
+	if !styles.Selected.GetReverse() {
+		t.Fatal("expected the selection to use reverse video without colors")
+	}
+	if _, ok := styles.Selected.GetBackground().(lipgloss.NoColor); !ok {
+		t.Fatalf("expected no background color, got %v", styles.Selected.GetBackground())
+	}
+
+	dark, _ := gotickets.ResolveTheme("dark", false)
+	if gotickets.NewStyles(dark).Selected.GetBackground() != lipgloss.Color("12") {
+		t.Fatal("expected the dark theme to keep the blue selection")
+	}
+}
+
+func TestUI_UnknownThemeWarning(t *testing.T) {
+	tempDir := t.TempDir()
+	mockFS := mocks.NewMockFileSystem(tempDir)
+	configPath := filepath.Join(tempDir, ".gotickets", "config.json")
+	if err := mockFS.WriteFile(configPath, []byte(`{"theme": "solarized"}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	model := gotickets.NewModelWithFS(mockFS)
+	if view := model.View(); !strings.Contains(view, "solarized") {
+		t.Fatalf("expected unknown theme warning, got:\n%s", view)
+	}
+}
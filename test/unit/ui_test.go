@@ -1,10 +1,15 @@
 package unit
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/charmbracelet/bubbletea"
+	"gotickets/internal/storage"
 	"gotickets/pkg/gotickets"
+	"gotickets/test/mocks"
 )
 
 func TestNewModel(t *testing.T) {
@@ -39,3 +44,334 @@ func TestModel_Update_WindowResize(t *testing.T) {
 		t.Fatal("Expected Update() to return a model")
 	}
 }
+
+// sendKeys feeds key messages through Update and returns the resulting model
+func sendKeys(t *testing.T, model gotickets.Model, keys ...tea.KeyMsg) gotickets.Model {
+	t.Helper()
+	for _, k := range keys {
+		updated, _ := model.Update(k)
+		next, ok := updated.(gotickets.Model)
+		if !ok {
+			t.Fatalf("Update() returned unexpected model type %T", updated)
+		}
+		model = next
+	}
+	return model
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// newTestModel returns a 120x40 model on a mock file system with the tickets
+// saved, each linked to https://example.com/<title in lower case>
+func newTestModel(t *testing.T, titles ...string) (gotickets.Model, *mocks.MockFileSystem) {
+	t.Helper()
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	model := gotickets.NewModelWithFS(mockFS)
+	model.SetStorage(storage.NewTicketStorage(mockFS))
+	for _, title := range titles {
+		url := "https://example.com/" + strings.ReplaceAll(strings.ToLower(title), " ", "-")
+		if _, err := model.GetStorage().AddTicket(title, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := model.GetStorage().Save(); err != nil {
+		t.Fatal(err)
+	}
+	model.RefreshList()
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return updated.(gotickets.Model), mockFS
+}
+
+func TestModel_SaveFailureIsShownAndRetryable(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	model := gotickets.NewModel()
+	model.SetStorage(storage.NewTicketStorage(mockFS))
+	model.RefreshList()
+
+	mockFS.SetError("WriteFile", mocks.AssertErr("disk full"))
+	model = sendKeys(t, model,
+		runes("a"), runes("https://example.com/1"), tea.KeyMsg{Type: tea.KeyEnter},
+		runes("Title"), tea.KeyMsg{Type: tea.KeyEnter},
+	)
+
+	if !strings.Contains(model.View(), "disk full") {
+		t.Fatalf("expected save error in view, got:\n%s", model.View())
+	}
+	if len(model.GetStorage().Tickets) != 1 {
+		t.Fatalf("expected unsaved ticket to stay in memory, got %d", len(model.GetStorage().Tickets))
+	}
+
+	mockFS.ClearError("WriteFile")
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(loaded.Tickets) != 1 {
+		t.Fatalf("expected retry to save the ticket, got %d on disk", len(loaded.Tickets))
+	}
+	if strings.Contains(model.View(), "несохраненные") {
+		t.Fatalf("expected dirty marker to be cleared after retry, got:\n%s", model.View())
+	}
+}
+
+func TestModel_SearchParseErrorShownInline(t *testing.T) {
+	model := gotickets.NewModel()
+	model.SetStorage(storage.NewTicketStorage(mocks.NewMockFileSystem(t.TempDir())))
+	model.RefreshList()
+
+	model = sendKeys(t, model, runes("/"), runes("id:abc"))
+	if !strings.Contains(model.View(), "id должен быть числом") {
+		t.Fatalf("expected parse error under the search input, got:\n%s", model.View())
+	}
+
+	// Enter keeps the search open until the query is fixed
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEnter})
+	if !model.IsSearchMode() {
+		t.Fatal("expected to stay in search mode on a parse error")
+	}
+}
+
+func TestModel_SavedSearchQuickFilter(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Fix login", "https://github.com/org/repo/issues/1")
+	ticketStorage.AddTicket("Docs", "https://example.com/docs")
+	model := gotickets.NewModel()
+	model.SetStorage(ticketStorage)
+	model.RefreshList()
+	// The list truncates its title to the window width
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	model = updated.(gotickets.Model)
+
+	model = sendKeys(t, model,
+		runes("/"), runes("host:github.com"), tea.KeyMsg{Type: tea.KeyEnter},
+		runes("F"), runes("GitHub"), tea.KeyMsg{Type: tea.KeyEnter},
+	)
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(loaded.SavedSearches) != 1 || loaded.SavedSearches[0].Name != "GitHub" {
+		t.Fatalf("expected the filter to be saved, got %+v", loaded.SavedSearches)
+	}
+
+	model = sendKeys(t, model, runes("r"), runes("1"))
+	view := model.View()
+	if !strings.Contains(view, "Фильтр «GitHub»") || !strings.Contains(view, "Показано: 1 из 2") {
+		t.Fatalf("expected the saved filter to be applied, got:\n%s", view)
+	}
+}
+
+func TestModel_StateRestoredOnStart(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Fix login", "https://github.com/org/repo/issues/1")
+	ticketStorage.AddTicket("Docs", "https://example.com/docs")
+	ticketStorage.AddTicket("Fix logout", "https://github.com/org/repo/issues/2")
+	if err := ticketStorage.Save(); err != nil {
+		t.Fatalf("failed to save tickets: %v", err)
+	}
+
+	model := gotickets.NewModelWithFS(mockFS)
+	model = sendKeys(t, model, runes("/"), runes("host:github.com"), tea.KeyMsg{Type: tea.KeyEnter}, tea.KeyMsg{Type: tea.KeyDown})
+	if err := model.SaveState(); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+
+	restored := gotickets.NewModelWithFS(mockFS)
+	state := restored.State()
+	if state.Query != "host:github.com" || state.SelectedID != 3 {
+		t.Fatalf("expected query and selection to be restored, got %+v", state)
+	}
+	if len(restored.GetStorage().Tickets) != 3 || !strings.Contains(restored.View(), "Fix logout") || strings.Contains(restored.View(), "Docs") {
+		t.Fatalf("expected the filtered list to be restored, got:\n%s", restored.View())
+	}
+}
+
+func TestModel_CycleSort(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Beta", "https://example.com/task/10")
+	ticketStorage.AddTicket("Alpha", "https://example.com/task/9")
+	model := gotickets.NewModelWithFS(mockFS)
+	model.SetStorage(ticketStorage)
+	model.RefreshList()
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	model = updated.(gotickets.Model)
+
+	// added -> newest first -> oldest first -> title
+	model = sendKeys(t, model, runes("s"), runes("s"), runes("s"))
+	view := model.View()
+	if !strings.Contains(view, "Сортировка: по названию") {
+		t.Fatalf("expected the sort mode in the title, got:\n%s", view)
+	}
+	if strings.Index(view, "Alpha") > strings.Index(view, "Beta") {
+		t.Fatalf("expected tickets sorted by title, got:\n%s", view)
+	}
+	if state := model.State(); state.Sort != string(storage.SortTitle) {
+		t.Fatalf("expected sort mode in the saved state, got %+v", state)
+	}
+}
+
+func TestModel_GroupedViewCollapse(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Login", "https://github.com/o/r/issues/1")
+	ticketStorage.AddTicket("Deploy", "https://jira.local/browse/OPS-7")
+	model := gotickets.NewModelWithFS(mockFS)
+	model.SetStorage(ticketStorage)
+	model.RefreshList()
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	model = updated.(gotickets.Model)
+
+	model = sendKeys(t, model, runes("z"))
+	view := model.View()
+	if !strings.Contains(view, "github.com (1)") || !strings.Contains(view, "jira.local (1)") {
+		t.Fatalf("expected host group headers, got:\n%s", view)
+	}
+
+	// The selected ticket stays under the cursor; its header is right above
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyEnter})
+	view = model.View()
+	if !strings.Contains(view, "▸ github.com (1)") || strings.Contains(view, "Login") || !strings.Contains(view, "Deploy") {
+		t.Fatalf("expected the github.com group to be collapsed, got:\n%s", view)
+	}
+	if state := model.State(); state.Group != "host" || len(state.Collapsed) != 1 {
+		t.Fatalf("expected grouping in the saved state, got %+v", state)
+	}
+}
+
+func TestModel_ImportSaveFailureIsNotALineError(t *testing.T) {
+	model, mockFS := newTestModel(t, "First")
+	importPath := filepath.Join(t.TempDir(), "import.txt")
+	if err := mockFS.WriteFile(importPath, []byte("https://example.com/new - New\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	model = sendKeys(t, model, runes("i"), runes(importPath), tea.KeyMsg{Type: tea.KeyEnter})
+	// Backups still work, only saving tickets.json fails
+	mockFS.SetError("MkdirAll", mocks.AssertErr("disk full"))
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEnter})
+
+	view := model.View()
+	if !strings.Contains(view, "Импорт не выполнен") || !strings.Contains(view, "disk full") {
+		t.Fatalf("expected the save error in the status line, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Ошибок формата: 0") {
+		t.Fatalf("expected no line errors, got:\n%s", view)
+	}
+}
+
+func TestModel_SearchCountLeavesOutArchived(t *testing.T) {
+	model, _ := newTestModel(t, "First", "Second", "Third")
+	model.GetStorage().Tickets[0].Archived = true
+	model.RefreshList()
+
+	model = sendKeys(t, model, runes("/"), runes("title:second"), tea.KeyMsg{Type: tea.KeyEnter})
+	if view := model.View(); !strings.Contains(view, "Показано: 1 из 2") {
+		t.Fatalf("expected archived tickets not to be counted, got:\n%s", view)
+	}
+
+	model = sendKeys(t, model, runes("/"), runes("archived:yes"), tea.KeyMsg{Type: tea.KeyEnter})
+	if view := model.View(); !strings.Contains(view, "Показано: 1 из 3") {
+		t.Fatalf("expected archived tickets to be counted when searched for, got:\n%s", view)
+	}
+}
+
+func TestModel_DeleteKeepsSearch(t *testing.T) {
+	model, _ := newTestModel(t, "Login page", "Deploy", "Login form")
+
+	model = sendKeys(t, model, runes("/"), runes("title:login"), tea.KeyMsg{Type: tea.KeyEnter}, runes("d"), runes("y"))
+
+	view := model.View()
+	if !strings.Contains(view, "Показано: 1 из 2") || strings.Contains(view, "Deploy") {
+		t.Fatalf("expected the search to stay after deleting, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Login form") || strings.Contains(view, "Login page") {
+		t.Fatalf("expected the first match to be deleted, got:\n%s", view)
+	}
+}
+
+func TestModel_BulkDeleteSelected(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	for _, url := range []string{"https://example.com/1", "https://example.com/2", "https://example.com/3", "https://example.com/4"} {
+		ticketStorage.AddTicket("Ticket "+url[len(url)-1:], url)
+	}
+	model := gotickets.NewModelWithFS(mockFS)
+	model.SetStorage(ticketStorage)
+	model.RefreshList()
+
+	// Space selects and moves down; V marks a range from ticket 3 to 4
+	model = sendKeys(t, model, runes(" "), tea.KeyMsg{Type: tea.KeyDown}, runes("V"), tea.KeyMsg{Type: tea.KeyDown}, runes("V"))
+	if !strings.Contains(model.View(), "Выбрано: 3") {
+		t.Fatalf("expected 3 selected tickets, got:\n%s", model.View())
+	}
+
+	model = sendKeys(t, model, runes("x"), runes("d"))
+	if model.GetViewMode() != gotickets.ViewConfirmBulk || !strings.Contains(model.View(), "Удалить тикеты: 3") {
+		t.Fatalf("expected a single confirmation, got:\n%s", model.View())
+	}
+	model = sendKeys(t, model, runes("y"))
+
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if len(loaded.Tickets) != 1 || loaded.Tickets[0].ID != 2 {
+		t.Fatalf("expected only ticket 2 to remain, got %+v", loaded.Tickets)
+	}
+	if strings.Contains(model.View(), "Выбрано:") {
+		t.Fatalf("expected the selection to be cleared, got:\n%s", model.View())
+	}
+}
+
+func TestModel_PinnedTicketsStayOnTop(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Alpha", "https://example.com/task/1")
+	ticketStorage.AddTicket("Zulu", "https://example.com/task/2")
+	model := gotickets.NewModelWithFS(mockFS)
+	model.SetStorage(ticketStorage)
+	model.RefreshList()
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	model = updated.(gotickets.Model)
+
+	// Pin Zulu, then sort by title and filter it out: it stays on top
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyDown}, runes("*"), runes("s"), runes("s"), runes("s"),
+		runes("/"), runes("alpha"), tea.KeyMsg{Type: tea.KeyEnter})
+	view := model.View()
+	if !strings.Contains(view, "★") || strings.Index(view, "Zulu") > strings.Index(view, "Alpha") {
+		t.Fatalf("expected the pinned ticket first, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Показано: 2 из 2") {
+		t.Fatalf("expected the pinned ticket to be counted, got:\n%s", view)
+	}
+	// Selecting all only takes the tickets matching the search
+	model = sendKeys(t, model, runes("A"))
+	if !strings.Contains(model.View(), "Выбрано: 1") {
+		t.Fatalf("expected only the matching ticket selected, got:\n%s", model.View())
+	}
+	loaded, _ := storage.LoadTicketsWithFS(mockFS)
+	if pinned := loaded.PinnedTickets(); len(pinned) != 1 || pinned[0].Title != "Zulu" {
+		t.Fatalf("expected the pin to be saved, got %+v", pinned)
+	}
+}
+
+func TestModel_DetailViewShowsRelativeTimes(t *testing.T) {
+	mockFS := mocks.NewMockFileSystem(t.TempDir())
+	ticketStorage := storage.NewTicketStorage(mockFS)
+	ticketStorage.AddTicket("Login", "https://example.com/task/1")
+	opened := time.Now().Add(-2 * time.Hour)
+	ticketStorage.Tickets[0].LastOpenedAt = &opened
+	ticketStorage.Tickets[0].OpenCount = 3
+	model := gotickets.NewModelWithFS(mockFS)
+	model.SetStorage(ticketStorage)
+	model.RefreshList()
+
+	model = sendKeys(t, model, runes("v"))
+	view := model.View()
+	if !strings.Contains(view, "2 ч назад") || !strings.Contains(view, "всего 3") || !strings.Contains(view, "никогда") {
+		t.Fatalf("expected usage with relative times, got:\n%s", view)
+	}
+
+	model = sendKeys(t, model, tea.KeyMsg{Type: tea.KeyEsc}, runes("h"))
+	if model.GetViewMode() != gotickets.ViewRecent || !strings.Contains(model.View(), "Login") {
+		t.Fatalf("expected the recent view with the opened ticket, got:\n%s", model.View())
+	}
+}
@@ -0,0 +1,114 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"gotickets/internal/storage"
+	"gotickets/test/mocks"
+)
+
+func TestURLPolicy_Normalize(t *testing.T) {
+	policy := storage.URLPolicy{TrackerBaseURL: "https://jira.example.com/browse/"}
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"  https://example.com/1  ", "https://example.com/1"},
+		{"HTTPS://example.com/Path", "https://example.com/Path"},
+		{"github.com/user/repo/issues/1", "https://github.com/user/repo/issues/1"},
+		{"localhost:8080/tasks/5", "https://localhost:8080/tasks/5"},
+		{"proj-123", "https://jira.example.com/browse/PROJ-123"},
+	}
+	for _, tc := range testCases {
+		got, err := policy.Normalize(tc.input)
+		if err != nil {
+			t.Errorf("Normalize(%q) failed: %v", tc.input, err)
+			continue
+		}
+		if got != tc.expected {
+			t.Errorf("Normalize(%q) = %q, want %q", tc.input, got, tc.expected)
+		}
+	}
+
+	for _, input := range []string{"", "htps:/example.com", "https:/example.com", "ftp://example.com", "some pasted text", "javascript:alert(1)"} {
+		if _, err := policy.Normalize(input); !errors.Is(err, storage.ErrInvalidURL) {
+			t.Errorf("Normalize(%q) error = %v, want ErrInvalidURL", input, err)
+		}
+	}
+}
+
+func TestURLPolicy_TicketKeys(t *testing.T) {
+	if _, err := (storage.URLPolicy{}).Normalize("PROJ-1"); !errors.Is(err, storage.ErrInvalidURL) {
+		t.Errorf("expected ticket key without tracker to be rejected, got %v", err)
+	}
+
+	policy := storage.URLPolicy{TrackerBaseURL: "https://tracker.example.com/issues?key={key}"}
+	got, err := policy.Normalize("ABC-7")
+	if err != nil || got != "https://tracker.example.com/issues?key=ABC-7" {
+		t.Errorf("Normalize(ABC-7) = %q, %v", got, err)
+	}
+
+	schemes := storage.URLPolicy{AllowedSchemes: []string{"https", "ftp"}}
+	if _, err := schemes.Normalize("ftp://files.example.com/1"); err != nil {
+		t.Errorf("expected configured scheme to be accepted, got %v", err)
+	}
+	if _, err := schemes.Normalize("http://example.com"); !errors.Is(err, storage.ErrInvalidURL) {
+		t.Errorf("expected http to be rejected when not configured, got %v", err)
+	}
+}
+
+func TestURLPolicy_ProjectTemplates(t *testing.T) {
+	policy := storage.URLPolicy{
+		URLTemplates: map[string]string{
+			"PROJ": "https://jira.local/browse/{key}",
+			"OPS":  "https://redmine.local/issues/{number}",
+		},
+		DefaultProject: "PROJ",
+	}
+
+	testCases := []struct {
+		input, url, key string
+	}{
+		{"PROJ-1234", "https://jira.local/browse/PROJ-1234", "PROJ-1234"},
+		{"1234", "https://jira.local/browse/PROJ-1234", "PROJ-1234"},
+		{"ops-7", "https://redmine.local/issues/7", "OPS-7"},
+		// Links built from a template round-trip to their key
+		{"https://jira.local/browse/PROJ-99", "https://jira.local/browse/PROJ-99", "PROJ-99"},
+		{"https://redmine.local/issues/8", "https://redmine.local/issues/8", "OPS-8"},
+		{"https://github.com/user/repo/issues/1", "https://github.com/user/repo/issues/1", ""},
+	}
+	for _, tc := range testCases {
+		resolved, err := policy.Resolve(tc.input)
+		if err != nil {
+			t.Errorf("Resolve(%q) failed: %v", tc.input, err)
+			continue
+		}
+		if resolved.URL != tc.url || resolved.Key != tc.key {
+			t.Errorf("Resolve(%q) = %+v, want url %q key %q", tc.input, resolved, tc.url, tc.key)
+		}
+	}
+
+	if _, err := (storage.URLPolicy{}).Resolve("1234"); !errors.Is(err, storage.ErrInvalidURL) {
+		t.Errorf("expected bare number without default project to be rejected, got %v", err)
+	}
+}
+
+func TestTicketStorage_AddTicketByKey(t *testing.T) {
+	ticketStorage := storage.NewTicketStorage(mocks.NewMockFileSystem(t.TempDir()))
+	ticketStorage.SetURLPolicy(storage.URLPolicy{
+		URLTemplates: map[string]string{"PROJ": "https://jira.local/browse/{key}"},
+	})
+
+	ticket, err := ticketStorage.AddTicket("Login bug", "proj-1234")
+	if err != nil {
+		t.Fatalf("AddTicket failed: %v", err)
+	}
+	if ticket.URL != "https://jira.local/browse/PROJ-1234" || ticket.ExtractTicketNumber() != "PROJ-1234" {
+		t.Fatalf("unexpected ticket: %+v (number %s)", ticket, ticket.ExtractTicketNumber())
+	}
+	if _, err := ticketStorage.AddTicket("Again", "https://jira.local/browse/PROJ-1234"); !errors.Is(err, storage.ErrDuplicateURL) {
+		t.Fatalf("expected duplicate of expanded key to be rejected, got %v", err)
+	}
+}